@@ -0,0 +1,148 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+
+	"github.com/DanielRivasMD/Babel/internal/bindings"
+	"github.com/DanielRivasMD/horus"
+	"github.com/spf13/cobra"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// convertCmd turns parsed bindings between babel's own EDN sources and a
+// Karabiner-Elements complex_modifications JSON document.
+var convertCmd = &cobra.Command{
+	Use:     "convert",
+	Short:   "Convert bindings between EDN and Karabiner JSON",
+	Long:    helpConvert,
+	Example: exampleConvert,
+
+	PreRun: preConvert,
+	Run:    runConvert,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	rootCmd.AddCommand(convertCmd)
+
+	convertCmd.Flags().StringVarP(&flags.ednFile, "file", "f", "", "Path to your EDN file (when --from edn)")
+	convertCmd.Flags().StringVarP(&flags.jsonFile, "json", "j", "", "Path to a karabiner.json file (when --from json)")
+	convertCmd.Flags().StringVarP(&flags.convertFrom, "from", "", "edn", "Source format: edn or json")
+	convertCmd.Flags().StringVarP(&flags.convertTo, "to", "", "json", "Target format: edn or json")
+	convertCmd.Flags().StringVarP(&flags.convertOutput, "output", "o", "", "Write the converted document here instead of stdout")
+	convertCmd.Flags().StringVarP(&flags.convertTitle, "title", "", "babel", "Title for the karabiner.json complex_modifications document")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func preConvert(cmd *cobra.Command, args []string) {
+	horus.CheckEmpty(
+		flags.convertFrom,
+		"",
+		horus.WithMessage("`--from` is required"),
+		horus.WithExitCode(2),
+		horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+	)
+	horus.CheckEmpty(
+		flags.convertTo,
+		"",
+		horus.WithMessage("`--to` is required"),
+		horus.WithExitCode(2),
+		horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func runConvert(cmd *cobra.Command, args []string) {
+	entries, err := readConvertEntries(flags.convertFrom)
+	if err != nil {
+		log.Fatalf("failed to read %s source: %v", flags.convertFrom, err)
+	}
+
+	var w io.Writer = cmd.OutOrStdout()
+	if flags.convertOutput != "" {
+		f, err := os.Create(flags.convertOutput)
+		if err != nil {
+			log.Fatalf("failed to create %q: %v", flags.convertOutput, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := writeConvertEntries(w, flags.convertTo, entries); err != nil {
+		log.Fatalf("failed to write %s target: %v", flags.convertTo, err)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// readConvertEntries loads entries from whichever source format was
+// requested, handing back the shared bindings.Entry model.
+func readConvertEntries(from string) ([]bindings.Entry, error) {
+	switch from {
+	case "edn":
+		paths := resolveEDNFiles(flags.ednFile, flags.rootDir)
+		parsed, err := parseEDNFiles(paths)
+		if err != nil {
+			return nil, err
+		}
+		return toBindingEntries(filterByProgram(parsed, flags.program)), nil
+
+	case "json":
+		data, err := os.ReadFile(flags.jsonFile)
+		if err != nil {
+			return nil, err
+		}
+		return bindings.DecodeKarabiner(data)
+
+	default:
+		log.Fatalf("unsupported --from %q (want edn or json)", from)
+		return nil, nil
+	}
+}
+
+// writeConvertEntries renders entries as whichever target format was
+// requested.
+func writeConvertEntries(w io.Writer, to string, entries []bindings.Entry) error {
+	switch to {
+	case "json":
+		cm := bindings.EncodeKarabiner(flags.convertTitle, entries)
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(cm)
+
+	case "edn":
+		_, err := io.WriteString(w, bindings.EncodeEDN(entries))
+		return err
+
+	default:
+		log.Fatalf("unsupported --to %q (want edn or json)", to)
+		return nil
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
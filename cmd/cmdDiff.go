@@ -0,0 +1,105 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"log"
+
+	"github.com/DanielRivasMD/Babel/internal/diff"
+	"github.com/DanielRivasMD/horus"
+	"github.com/spf13/cobra"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// diffCmd turns two EDN revisions into a reviewable change report instead
+// of an opaque side-by-side file diff: added/removed triggers, per-action
+// changes, and (--format markdown) a keyboard diagram highlighting what
+// moved.
+var diffCmd = &cobra.Command{
+	Use:     "diff",
+	Short:   "Diff bindings between two EDN revisions",
+	Long:    helpDiff,
+	Example: exampleDiff,
+
+	PreRun: preDiff,
+	Run:    runDiff,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringVarP(&flags.diffOld, "old", "", "", "Path to the earlier EDN revision")
+	diffCmd.Flags().StringVarP(&flags.diffNew, "new", "", "", "Path to the later EDN revision")
+	diffCmd.Flags().StringVarP(&flags.diffFormat, "format", "o", "text", "Output format: text, markdown, or json")
+	diffCmd.Flags().StringVarP(&flags.diffKeyboard, "keyboard", "k", "ansi", "Keyboard shape for --format markdown: ansi, iso, or ortholinear")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func preDiff(cmd *cobra.Command, args []string) {
+	horus.CheckEmpty(
+		flags.diffOld,
+		"",
+		horus.WithMessage("`--old` is required"),
+		horus.WithExitCode(2),
+		horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+	)
+	horus.CheckEmpty(
+		flags.diffNew,
+		"",
+		horus.WithMessage("`--new` is required"),
+		horus.WithExitCode(2),
+		horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func runDiff(cmd *cobra.Command, args []string) {
+	oldEntries, err := parseEDNFile(flags.diffOld)
+	if err != nil {
+		log.Fatalf("failed to read --old %q: %v", flags.diffOld, err)
+	}
+	newEntries, err := parseEDNFile(flags.diffNew)
+	if err != nil {
+		log.Fatalf("failed to read --new %q: %v", flags.diffNew, err)
+	}
+
+	changes := diff.Diff(toBindingEntries(oldEntries), toBindingEntries(newEntries))
+
+	var renderErr error
+	switch flags.diffFormat {
+	case "text":
+		renderErr = renderDiffText(changes, cmd.OutOrStdout())
+	case "json":
+		renderErr = renderDiffJSON(changes, cmd.OutOrStdout())
+	case "markdown":
+		renderErr = renderDiffMarkdown(changes, flags.diffKeyboard, cmd.OutOrStdout())
+	default:
+		log.Fatalf("unsupported --format %q (want text, markdown, or json)", flags.diffFormat)
+	}
+	if renderErr != nil {
+		log.Fatalf("render error: %v", renderErr)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -18,10 +18,12 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"os"
 	"sort"
 	"strings"
 
 	"github.com/DanielRivasMD/horus"
+	"github.com/mattn/go-runewidth"
 	"github.com/spf13/cobra"
 	"github.com/ttacon/chalk"
 )
@@ -64,8 +66,13 @@ func init() {
 	rootCmd.AddCommand(displayCmd)
 
 	displayCmd.Flags().StringVarP(&flags.ednFile, "file", "f", "", "Path to your EDN file")
-	displayCmd.Flags().StringVarP(&flags.renderMode, "render", "m", "DEFAULT", "Which rows to render: EMPTY (only empty program+action), FULL (all), DEFAULT (non-empty program+action)")
+	displayCmd.Flags().StringVarP(&flags.renderMode, "render", "m", "DEFAULT", "Which rows to render: EMPTY (only empty program+action), FULL (all), TREE (chord tree), DEFAULT (non-empty program+action)")
 	displayCmd.Flags().StringVarP(&flags.sortBy, "sort", "s", "trigger", "Sort output by one of: program, action, trigger, binding")
+	displayCmd.Flags().BoolVarP(&flags.treeMarkdown, "markdown", "", false, "Render TREE mode as nested Markdown <details> blocks instead of box-drawing")
+	displayCmd.Flags().StringVarP(&flags.displayFormat, "format", "o", "ascii", "Output renderer: ascii, markdown, html, json, csv, yaml, or pretty")
+	displayCmd.Flags().BoolVarP(&flags.displayWatch, "watch", "w", false, "Mount a live-reload TUI that re-parses the EDN sources on change")
+	displayCmd.Flags().BoolVarP(&flags.displayLint, "lint", "", false, "Report duplicate, shadowed, and default-key bindings instead of rendering the table")
+	displayCmd.Flags().BoolVarP(&flags.displayPick, "interactive", "i", false, "Mount a fuzzy-filterable picker TUI; Enter copies the Binding, \"g\" prints its source file:line")
 
 	horus.CheckErr(
 		displayCmd.RegisterFlagCompletionFunc("render", completeRenderType),
@@ -79,6 +86,11 @@ func init() {
 		horus.WithMessage("registering config completion for flag sort"),
 	)
 
+	horus.CheckErr(
+		displayCmd.RegisterFlagCompletionFunc("format", completeFormatType),
+		horus.WithOp("display.init"),
+		horus.WithMessage("registering config completion for flag format"),
+	)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -87,39 +99,82 @@ func init() {
 // TODO: update error handlers
 // TODO: simplify run call
 func runDisplay(cmd *cobra.Command, args []string) {
-	// Resolve EDN file paths
-	paths := resolveEDNFiles(flags.ednFile, flags.rootDir)
+	if flags.displayWatch {
+		runDisplayWatch()
+		return
+	}
+
+	if flags.displayPick {
+		runDisplayPicker()
+		return
+	}
+
+	if flags.displayLint {
+		entries, err := loadAllEntries()
+		if err != nil {
+			log.Fatalf("EDN parsing error: %v", err)
+		}
 
-	// Parse all EDN files into structured bindings
-	allEntries, err := parseEDNFiles(paths)
+		issues := lintEntries(entries)
+		if err := renderLintIssues(flags.displayFormat, issues, cmd.OutOrStdout()); err != nil {
+			log.Fatalf("render error: %v", err)
+		}
+		if len(issues) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	final, err := loadDisplayEntries()
 	if err != nil {
 		log.Fatalf("EDN parsing error: %v", err)
 	}
 
-	// Filter by program
-	filtered := filterByProgram(allEntries, flags.program)
+	if strings.ToUpper(flags.renderMode) == "TREE" {
+		renderChordTree(final, flags.treeMarkdown)
+		return
+	}
+
+	renderer, ok := lookupRenderer(flags.displayFormat)
+	if !ok {
+		log.Fatalf("unsupported --format %q (want %s)", flags.displayFormat, strings.Join(rendererNames(), ", "))
+	}
+	if err := renderer.Render(final, cmd.OutOrStdout()); err != nil {
+		log.Fatalf("render error: %v", err)
+	}
+}
+
+// loadDisplayEntries resolves, parses, program-filters, and render-mode
+// filters the configured EDN sources into the entries that should be shown.
+// TREE mode is handled by its own renderChordTree path and is returned
+// unfiltered here, since it walks BindingEntry directly. Shared by the
+// one-shot Run path and the --watch TUI so both stay in lockstep.
+func loadDisplayEntries() ([]BindingEntry, error) {
+	filtered, err := loadAllEntries()
+	if err != nil {
+		return nil, err
+	}
 
-	// Apply render mode
-	var final []BindingEntry
 	switch strings.ToUpper(flags.renderMode) {
-	case "FULL":
-		final = filtered
+	case "FULL", "TREE":
+		return filtered, nil
 	case "EMPTY":
+		var final []BindingEntry
 		for _, e := range filtered {
-			fmt.Println(e)
 			if isEmptyEntry(e) {
 				final = append(final, e)
 			}
 		}
+		return final, nil
 	default: // "DEFAULT"
+		var final []BindingEntry
 		for _, e := range filtered {
 			if !isEmptyEntry(e) {
 				final = append(final, e)
 			}
 		}
+		return final, nil
 	}
-
-	emitTable(final)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -131,6 +186,13 @@ type tableRow struct {
 	Trigger string
 	Binding string
 	Empty   bool
+
+	// sourcePath/sourceLine locate the "^{...}[...]" block the row came
+	// from, for the --interactive picker's jump-to-source action. Left
+	// zero for rows that didn't come from a parsed BindingEntry (e.g.
+	// lintCmd's synthesized rows).
+	sourcePath string
+	sourceLine int
 }
 
 // buildKeySequence joins the second element of the rule vector into a string
@@ -150,30 +212,27 @@ func buildKeySequence(x any) string {
 	}
 }
 
-// emitTable prints all rows as a Markdown table, sorted by --sort
-func emitTable(entries []BindingEntry) {
-	if len(entries) == 0 {
-		fmt.Println("No bindings found.")
-		return
-	}
-
-	// Flatten entries into rows
+// buildRows flattens entries into tableRows, one per action, sorted by
+// --sort. Shared by every Renderer so ascii/markdown/html/json all agree on
+// row order.
+func buildRows(entries []BindingEntry) []tableRow {
 	var rows []tableRow
 	for _, entry := range entries {
 		for _, action := range entry.Actions {
-			trigger := formatKeySeq(entry.Trigger, lookups.displayTrigger, action.Program)
+			trigger := formatKeySeq(entry.Trigger, lookups.displayTrigger, action.Program, "-")
 			binding := formatBindingEntry(entry, lookups.displayBinding, action.Program)
 			rows = append(rows, tableRow{
-				Program: action.Program,
-				Action:  action.Action,
-				Trigger: trigger,
-				Binding: binding,
-				Empty:   isEmptyEntry(entry),
+				Program:    action.Program,
+				Action:     action.Action,
+				Trigger:    trigger,
+				Binding:    binding,
+				Empty:      isEmptyEntry(entry),
+				sourcePath: entry.Path,
+				sourceLine: entry.Pos.Line,
 			})
 		}
 	}
 
-	// Sort rows
 	sort.Slice(rows, func(i, j int) bool {
 		switch strings.ToLower(flags.sortBy) {
 		case "program":
@@ -187,42 +246,24 @@ func emitTable(entries []BindingEntry) {
 		}
 	})
 
-	// Print table
-	fmt.Println(tableBorder)
-	fmt.Println(tableHeader)
-	fmt.Println(tableDivider)
-
-	for _, r := range rows {
-		// Pick program color
-		var progColor *chalk.Color
-		if c, ok := programColors[r.Program]; ok {
-			progColor = &c
-		}
-
-		// Build row with padded + colored cells
-		row := fmt.Sprintf("| %s | %s | %s | %s |\n",
-			renderCell(r.Program, 12, progColor),
-			renderCell(r.Action, 30, nil),
-			renderCell(r.Trigger, 20, nil),
-			renderCell(r.Binding, 20, nil),
-		)
-
-		// Dim entire row if empty
-		if r.Empty {
-			row = chalk.Dim.TextStyle(row)
-		}
+	return rows
+}
 
-		fmt.Print(row)
+// emitTable prints all rows as an ASCII table to stdout. Kept as a thin
+// wrapper over asciiRenderer for callers (key, parsejson) that don't go
+// through --format.
+func emitTable(entries []BindingEntry) {
+	if err := (asciiRenderer{}).Render(entries, os.Stdout); err != nil {
+		log.Fatalf("render error: %v", err)
 	}
-
-	fmt.Println(tableBorder)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// renderCell pads the raw value to width, then applies color if provided
+// renderCell pads val to its display width (accounting for wide runes via
+// runewidth), then applies color if provided.
 func renderCell(val string, width int, color *chalk.Color) string {
-	raw := fmt.Sprintf("%-*s", width, val) // pad first
+	raw := runewidth.FillRight(val, width)
 	if color != nil {
 		return color.Color(raw)
 	}
@@ -266,4 +307,14 @@ func completeSortType(cmd *cobra.Command, args []string, toComplete string) ([]s
 	return completions, cobra.ShellCompDirectiveNoFileComp
 }
 
+func completeFormatType(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var completions []string
+	for _, opt := range rendererNames() {
+		if strings.HasPrefix(opt, toComplete) {
+			completions = append(completions, opt)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,5 +1,5 @@
 /*
-Copyright © 2024 Daniel Rivas <danielrivasmd@gmail.com>
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
 
 This program is free software: you can redistribute it and/or modify
 it under the terms of the GNU General Public License as published by
@@ -16,107 +16,166 @@ along with this program. If not, see <http://www.gnu.org/licenses/>.
 */
 package cmd
 
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
 import (
 	"bufio"
-	"fmt"
+	"encoding/json"
+	"io"
 	"log"
 	"os"
 	"strings"
 
+	"github.com/BurntSushi/toml"
+	"github.com/DanielRivasMD/horus"
 	"github.com/spf13/cobra"
 )
 
-// docCmd represents the doc command
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// docCmd normalises a raw karabiner.edn into a canonical table of
+// {from_mods, from_key, to_mods, to_key, command} records.
 var docCmd = &cobra.Command{
-	Use:   "doc",
-	Short: "A brief description of your command",
-	Long: `A longer description that spans multiple lines and likely contains examples
-and usage of using your command. For example:
-
-Cobra is a CLI library for Go that empowers applications.
-This application is a tool to generate the needed files
-to quickly create a Cobra application.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("doc called")
-	},
+	Use:     "doc",
+	Short:   "Normalise a karabiner.edn into a canonical keymap table",
+	Long:    helpDoc,
+	Example: exampleDoc,
+
+	PreRun: preDoc,
+	Run:    runDoc,
 }
 
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
 func init() {
 	rootCmd.AddCommand(docCmd)
 
-	target := findHome() + "/" + ".saiyajin/karabiner/karabiner.edn"
-	// params := copyCR(target, "test.txt")
+	docCmd.Flags().StringVarP(&flags.docInput, "input", "i", "", "EDN file to normalise")
+	docCmd.Flags().StringVarP(&flags.docOutput, "output", "o", "", "Write the normalised table here instead of stdout")
+	docCmd.Flags().StringVarP(&flags.docFormat, "format", "", "toml", "Output table format: toml or json")
+}
 
-	// open reader
-	fread, ε := os.Open(target)
-	if ε != nil {
-		log.Fatal(ε)
-	}
-	defer fread.Close()
+////////////////////////////////////////////////////////////////////////////////////////////////////
 
-	// // open writer
-	// fwrite, ε := os.OpenFile(target, os.O_WRONLY|os.O_CREATE, 0666)
-	// if ε != nil {
-	// 	log.Fatal(ε)
-	// }
-	// defer fwrite.Close()
+func preDoc(cmd *cobra.Command, args []string) {
+	horus.CheckEmpty(
+		flags.docInput,
+		"",
+		horus.WithMessage("`--input` is required"),
+		horus.WithExitCode(2),
+		horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+	)
+}
 
-	// read file
-	scanner := bufio.NewScanner(fread)
+////////////////////////////////////////////////////////////////////////////////////////////////////
 
-	// scan file
-	for scanner.Scan() {
+// docRecord is one canonicalised keymap entry.
+type docRecord struct {
+	FromMods string `toml:"from_mods" json:"from_mods"`
+	FromKey  string `toml:"from_key" json:"from_key"`
+	ToMods   string `toml:"to_mods" json:"to_mods"`
+	ToKey    string `toml:"to_key" json:"to_key"`
+	Command  string `toml:"command" json:"command"`
+}
 
-	if strings.HasPrefix(scanner.Text(), "  [") {
-		fmt.Println(scanner.Text())
+////////////////////////////////////////////////////////////////////////////////////////////////////
 
-		// tab separated records
-		records := strings.Split(scanner.Text(), " ")
+func runDoc(cmd *cobra.Command, args []string) {
+	fread, err := os.Open(flags.docInput)
+	if err != nil {
+		log.Fatalf("failed to open %q: %v", flags.docInput, err)
+	}
+	defer fread.Close()
 
-		// fmt.Println(records)
-		// fmt.Println(records[0])
-		// fmt.Println(records[1])
+	var records []docRecord
+	scanner := bufio.NewScanner(fread)
+	for scanner.Scan() {
+		if !strings.HasPrefix(scanner.Text(), "  [") {
+			continue
+		}
+		if rec, ok := parseDocLine(scanner.Text()); ok {
+			records = append(records, rec)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("failed reading %q: %v", flags.docInput, err)
+	}
 
-		fr := records[2]
-		fr = strings.Replace(fr, "[:!", "", -1)
-		fr = strings.Replace(fr, "#P", "", -1)
-		fr = strings.Replace(fr, "O", "alt-", -1)
-		fr = strings.Replace(fr, "T", "ctl-", -1)
-		fr = strings.Replace(fr, "C", "cmd-", -1)
-		fmt.Println(fr)
+	var w io.Writer = cmd.OutOrStdout()
+	if flags.docOutput != "" {
+		f, err := os.Create(flags.docOutput)
+		if err != nil {
+			log.Fatalf("failed to create %q: %v", flags.docOutput, err)
+		}
+		defer f.Close()
+		w = f
+	}
 
-		to := records[3]
-		to = strings.Replace(to, ":!", "", -1)
-		to = strings.Replace(to, "S", "shift", -1)
-		fmt.Println(to)
+	writeDocRecords(w, records)
+}
 
-		fmt.Println(records[4])
+////////////////////////////////////////////////////////////////////////////////////////////////////
 
-		// identify potential lines
-		
+// parseDocLine matches the first two bracketed `[:!...]` tokens on a line
+// against the rg regex table, decodes their modifiers via
+// lookups.displayBinding, and treats the remainder of the line as the
+// command.
+func parseDocLine(line string) (docRecord, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return docRecord{}, false
 	}
 
-		// // write
-		// _, ε = ϖ.WriteString(toPrint)
-		// if ε != nil {
-		// 	log.Fatal(ε)
-		// }
-	}
+	fromMods, fromKey := splitEDNKey(strings.Trim(fields[0], "[]"))
+	toMods, toKey := splitEDNKey(strings.Trim(fields[1], "[]"))
 
-	if ε := scanner.Err(); ε != nil {
-		log.Fatal(ε)
-	}
+	command := strings.TrimSuffix(strings.Join(fields[2:], " "), "]")
 
-	// // flush writer
-	// ϖ.Flush()
+	return docRecord{
+		FromMods: decodeDocMods(fromMods),
+		FromKey:  fromKey,
+		ToMods:   decodeDocMods(toMods),
+		ToKey:    toKey,
+		Command:  strings.TrimSpace(command),
+	}, true
+}
 
-	// Here you will define your flags and configuration settings.
+// decodeDocMods renders a modifier-rune cluster (e.g. "OTC") through the
+// same display-binding lookup table the display/interpret commands use.
+func decodeDocMods(mods string) string {
+	lookup := lookups.displayBinding["default"]
+	if lookup == nil {
+		return mods
+	}
 
-	// Cobra supports Persistent Flags which will work for this command
-	// and all subcommands, e.g.:
-	// docCmd.PersistentFlags().String("foo", "", "A help for foo")
+	parts := make([]string, 0, len(mods))
+	for _, r := range mods {
+		parts = append(parts, lookup(string(r)))
+	}
+	return strings.Join(parts, "+")
+}
 
-	// Cobra supports local flags which will only run when this command
-	// is called directly, e.g.:
-	// docCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func writeDocRecords(w io.Writer, records []docRecord) {
+	switch strings.ToLower(flags.docFormat) {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(records); err != nil {
+			log.Fatalf("failed to encode records as json: %v", err)
+		}
+
+	case "toml":
+		if err := toml.NewEncoder(w).Encode(struct {
+			Records []docRecord `toml:"records"`
+		}{Records: records}); err != nil {
+			log.Fatalf("failed to encode records as toml: %v", err)
+		}
+
+	default:
+		log.Fatalf("unsupported --format %q (want toml or json)", flags.docFormat)
+	}
 }
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -20,10 +20,15 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"log"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/DanielRivasMD/Babel/internal/diffing"
 	"github.com/DanielRivasMD/horus"
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/cobra"
 )
 
@@ -45,6 +50,12 @@ func init() {
 	rootCmd.AddCommand(embedCmd)
 
 	embedCmd.Flags().StringVarP(&flags.embedTarget, "target", "", "", "Config file to supplement")
+	embedCmd.Flags().BoolVarP(&flags.embedExternal, "external-mbombo", "", false, "Shell out to the mbombo binary instead of forging the output in-process")
+	embedCmd.Flags().BoolVarP(&flags.embedDryRun, "dry-run", "", false, "Preview as a unified diff without writing; fail if any replace would be a no-op")
+	embedCmd.Flags().BoolVarP(&flags.embedDiff, "diff", "", false, "Preview as a unified diff without writing")
+	embedCmd.Flags().StringVarP(&flags.embedFenceStyle, "fence-style", "", "", "Comment syntax for the BEGIN/END babel fence markers (yaml, toml, kdl, edn)")
+	embedCmd.Flags().BoolVarP(&flags.embedNoFence, "no-fence", "", false, "Replace anchors directly in the target file instead of a fenced block (pre-fence behavior)")
+	embedCmd.Flags().BoolVarP(&flags.embedWatch, "watch", "w", false, "Re-run embed whenever the EDN sources change")
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -69,6 +80,11 @@ func preEmbed(cmd *cobra.Command, args []string) {
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 func runEmbed(cmd *cobra.Command, args []string) {
+	if flags.embedWatch {
+		runEmbedWatch(cmd)
+		return
+	}
+
 	// Resolve EDN file paths
 	paths := resolveEDNFiles(flags.ednFile, flags.rootDir)
 
@@ -79,16 +95,138 @@ func runEmbed(cmd *cobra.Command, args []string) {
 	}
 
 	// Embed for single target
-	embedConfig(allEntries, flags.program)
+	embedConfig(allEntries, flags.program, cmd.OutOrStdout())
+}
+
+// runEmbedWatch re-runs the same resolve -> parse -> embedConfig pipeline
+// runEmbed uses, on every EDN change under --root, debounced the same way
+// the plain `watch` command and `display --watch` are. A parse error is
+// reported through onelineErr and skipped rather than exiting, so a
+// transient syntax error while editing doesn't kill the watcher.
+func runEmbedWatch(cmd *cobra.Command) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Fatalf("failed to start EDN watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	if err := watchEDNDirs(watcher, flags.rootDir); err != nil {
+		log.Fatalf("failed to watch %s: %v", flags.rootDir, err)
+	}
+
+	reembed := func() {
+		paths := resolveEDNFiles(flags.ednFile, flags.rootDir)
+		allEntries, err := parseEDNFiles(paths)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, onelineErr(fmt.Sprintf("EDN parsing error: %v", err)))
+			return
+		}
+		embedConfig(allEntries, flags.program, cmd.OutOrStdout())
+	}
+
+	reembed()
+
+	var timer *time.Timer
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if !strings.HasSuffix(event.Name, ".edn") {
+				continue
+			}
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.AfterFunc(watchDebounce, reembed)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			fmt.Fprintln(os.Stderr, onelineErr(fmt.Sprintf("watch error: %v", err)))
+		}
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func embedConfig(entries []BindingEntry, target string) {
+func embedConfig(entries []BindingEntry, target string, w io.Writer) {
 	filtered := filterByProgram(entries, target)
+	noWrite := flags.embedDryRun || flags.embedDiff
+
+	horus.CheckErr(
+		detectConflicts(filtered, target),
+		horus.WithOp("embedConfig"),
+		horus.WithCategory("conflict_error"),
+		horus.WithMessage("Two bindings generated the same key for this program"),
+		horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+	)
 
+	if flags.embedNoFence {
+		embedConfigRaw(filtered, target, w, noWrite)
+		return
+	}
+
+	body := make(map[string]string)
 	switch {
 	case target == "broot":
+		for _, entry := range filtered {
+			for _, act := range entry.Actions {
+				bindKey := formatKeySeq(entry.Binding, lookups.embed, act.Program, "-")
+				body[bindKey] = formatBrootLine(bindKey, act)
+			}
+		}
+
+	case target == "lazygit":
+		rawBind := make(map[string]string)
+		for _, entry := range filtered {
+			for _, act := range entry.Actions {
+				bindKey := formatKeySeq(entry.Binding, lookups.embed, act.Program, "-")
+				rawBind[bindKey] = act.Command
+			}
+		}
+		formatted := formatBinds(rawBind, target)
+		for key, val := range formatted {
+			body[key] = fmt.Sprintf("    %s: '<%s>'", val, key)
+		}
+
+	case strings.HasPrefix(target, "zellij"):
+		normalized := normalizeProgram(target)
+		for _, entry := range filtered {
+			for _, act := range entry.Actions {
+				bindKey := formatKeySeq(entry.Binding, lookups.embed, normalized, " ")
+				body[bindKey] = formatZellijLine(bindKey, act)
+			}
+		}
+
+	default:
+		log.Fatalf("unsupported --program %q", target)
+	}
+
+	embedFenced(target, body, w, noWrite)
+}
+
+// embedConfigRaw is the pre-fence behavior, kept verbatim behind --no-fence:
+// forge replaces each generated line in place of its EDN-derived anchor,
+// anywhere it occurs in the target file.
+func embedConfigRaw(filtered []BindingEntry, target string, w io.Writer, noWrite bool) {
+	switch {
+	case target == "broot":
+		replaces := []mbomboReplace{}
+		for _, entry := range filtered {
+			for _, act := range entry.Actions {
+				bindKey := formatKeySeq(entry.Binding, lookups.embed, act.Program, "-")
+				replaces = append(replaces, formatBrootReplace(bindKey, act))
+			}
+		}
+
+		mbomboForging(
+			"embed-broot",
+			newMbomboConfig(flags.embedTarget, []string{flags.embedTarget}, replaces...),
+			w, noWrite,
+		)
 
 	case target == "lazygit":
 		rawBind := make(map[string]string)
@@ -103,11 +241,11 @@ func embedConfig(entries []BindingEntry, target string) {
 		replaces := []mbomboReplace{}
 		for key, val := range formatted {
 			replaces = append(replaces,
-				replace(val, fmt.Sprintf("    %s: '<%s>':line", val, key)))
+				ReplaceWholeLine(val, fmt.Sprintf("    %s: '<%s>'", val, key)))
 		}
 
 		mf := newMbomboConfig(flags.embedTarget, []string{flags.embedTarget}, replaces...)
-		mbomboForging("embed-lazygit", mf)
+		mbomboForging("embed-lazygit", mf, w, noWrite)
 
 	case strings.HasPrefix(target, "zellij"):
 		normalized := normalizeProgram(target)
@@ -123,6 +261,7 @@ func embedConfig(entries []BindingEntry, target string) {
 		mbomboForging(
 			"embed-zellij",
 			newMbomboConfig(flags.embedTarget, []string{flags.embedTarget}, replaces...),
+			w, noWrite,
 		)
 
 	default:
@@ -130,18 +269,97 @@ func embedConfig(entries []BindingEntry, target string) {
 	}
 }
 
+// embedFenced splices body (already keyed by binding key) into the
+// BEGIN/END babel:<target> fence inside flags.embedTarget, creating the
+// fence at EOF on a first run, and writes the result atomically unless
+// noWrite previews it as a diff instead — mirroring forge.Forge/Render's
+// write-vs-preview split, just against a fence instead of anchor replaces.
+func embedFenced(target string, body map[string]string, w io.Writer, noWrite bool) {
+	begin, end := fenceMarkers(flags.embedFenceStyle, target)
+
+	raw, rerr := os.ReadFile(flags.embedTarget)
+	if rerr != nil && !os.IsNotExist(rerr) {
+		horus.CheckErr(
+			rerr,
+			horus.WithOp("embedFenced"),
+			horus.WithCategory("read_error"),
+			horus.WithMessage("Failed to read embed target"),
+			horus.WithDetails(map[string]any{"target": flags.embedTarget}),
+		)
+	}
+	before := string(raw)
+	after := spliceFence(before, begin, end, sortedFenceBody(body))
+
+	if noWrite {
+		fmt.Fprint(w, diffing.Unified(before, after, "a/"+flags.embedTarget, "b/"+flags.embedTarget, 3))
+		if flags.embedDryRun && before == after {
+			log.Fatalf("embed --dry-run: %s would be unchanged (stale fence?)", flags.embedTarget)
+		}
+		return
+	}
+
+	tmp := flags.embedTarget + ".tmp"
+	horus.CheckErr(
+		os.WriteFile(tmp, []byte(after), 0o644),
+		horus.WithOp("embedFenced"),
+		horus.WithCategory("write_error"),
+		horus.WithMessage("Failed to write embed target"),
+		horus.WithDetails(map[string]any{"target": tmp}),
+	)
+	horus.CheckErr(
+		os.Rename(tmp, flags.embedTarget),
+		horus.WithOp("embedFenced"),
+		horus.WithCategory("write_error"),
+		horus.WithMessage("Failed to rename embed target into place"),
+		horus.WithDetails(map[string]any{"target": flags.embedTarget}),
+	)
+}
+
+// formatZellijLine renders one ProgramAction as the KDL bind line embed
+// puts in the fenced block (or splices in for --no-fence).
+func formatZellijLine(key string, act ProgramAction) string {
+	escapedCmd := escapeForMbombo(act.Command)
+	escapedCmd = strings.Trim(escapedCmd, "[]")
+	return fmt.Sprintf("        bind \\\"%s\\\" { %s }", key, escapedCmd)
+}
+
 func formatZellijReplace(key string, act ProgramAction) mbomboReplace {
-	// Escape the command dynamically
 	escapedCmd := escapeForMbombo(act.Command)
 	escapedCmd = strings.Trim(escapedCmd, "[]")
+	return ReplaceWholeLine(fmt.Sprintf("\"%s\"", escapedCmd), formatZellijLine(key, act))
+}
 
-	// Left-hand side is the command string as it appears in EDN (escaped for mbombo)
-	lhs := escapedCmd
+// formatBrootLine renders one ProgramAction as a broot [[verbs]] TOML
+// stanza: invocation names the verb after the action, key is the combo
+// formatKeySeq already normalized through a broot lookup entry (so e.g.
+// "ctrl-p" renders as broot expects it, not Karabiner's "C-p"), and
+// execution carries the shell command. ProgramAction has no
+// leave_broot/from_shell of its own, so this introduces the same kind of
+// convention formatZellijLine's annotation reading does: an Action naming
+// "shell" sets from_shell = true, and one naming "stay" keeps broot open
+// afterward (leave_broot = false) instead of broot's own default.
+func formatBrootLine(key string, act ProgramAction) string {
+	escapedCmd := escapeForMbombo(act.Command)
+	escapedCmd = strings.Trim(escapedCmd, "[]")
 
-	// Right-hand side is the KDL bind line
-	rhs := fmt.Sprintf("        bind \\\"%s\\\" { %s }:line", key, escapedCmd)
+	var extra strings.Builder
+	if strings.Contains(strings.ToLower(act.Action), "shell") {
+		extra.WriteString("from_shell = true\n")
+	}
+	if strings.Contains(strings.ToLower(act.Action), "stay") {
+		extra.WriteString("leave_broot = false\n")
+	}
 
-	return replace(fmt.Sprintf("\"%s\"", lhs), rhs)
+	return fmt.Sprintf(
+		"[[verbs]]\ninvocation = \"%s\"\nkey = \"%s\"\nexecution = \"%s\"\n%s",
+		act.Action, key, escapedCmd, extra.String(),
+	)
+}
+
+func formatBrootReplace(key string, act ProgramAction) mbomboReplace {
+	escapedCmd := escapeForMbombo(act.Command)
+	escapedCmd = strings.Trim(escapedCmd, "[]")
+	return ReplaceWholeLine(fmt.Sprintf("\"%s\"", escapedCmd), formatBrootLine(key, act))
 }
 
 // escapeForMbombo takes a raw command string (from EDN :exec)
@@ -25,6 +25,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/DanielRivasMD/Babel/emitters"
 	"github.com/DanielRivasMD/horus"
 	"github.com/spf13/cobra"
 )
@@ -91,14 +92,8 @@ func runInterpret(cmd *cobra.Command, args []string) {
 		w = f
 	}
 
-	// Define program families (expand only on exact family names)
-	families := map[string][]string{
-		"helix": {"helix-common", "helix-insert", "helix-normal", "helix-select"},
-		"micro": {"micro"},
-	}
-
-	// respect exact targets
-	if bases, ok := families[flags.program]; ok {
+	// respect exact families (expand only on exact family names)
+	if bases, ok := programCfg.Families[flags.program]; ok {
 		for _, b := range bases {
 			emitConfig(w, allEntries, b)
 			fmt.Fprintln(w)
@@ -124,50 +119,31 @@ func emitConfig(w io.Writer, entries []BindingEntry, target string) {
 		}
 	}
 
-	formatted := formatBinds(rawBind, target)
-
-	switch {
-	case strings.HasPrefix(target, "helix-"):
-		if headerLines, ok := programHeaders[target]; ok {
-			for _, line := range headerLines {
-				fmt.Fprintln(w, line)
-			}
-		}
-		for key, val := range formatted {
-			fmt.Fprintf(w, "%s = %s\n", key, val)
-		}
-
-	case target == "micro":
-		fmt.Fprintln(w, "{")
-		if headerLines, ok := programHeaders[target]; ok {
-			for _, line := range headerLines {
-				fmt.Fprintln(w, line)
-			}
-		}
-		for key, val := range formatted {
-			fmt.Fprintf(w, "  %q: %q,\n", key, val)
-		}
-		fmt.Fprintln(w, "}")
-
-	default:
+	em, ok := emitters.Lookup(target)
+	if !ok {
 		log.Fatalf("unsupported --program %q", target)
 	}
+
+	em.Header(w, programCfg.Programs[target].Headers)
+	for key, val := range rawBind {
+		em.Emit(w, key, em.FormatValue(val))
+	}
+	em.Footer(w)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 func formatBinds(raw map[string]string, program string) map[string]string {
+	desc := programCfg.Programs[program]
 	out := make(map[string]string, len(raw))
 
 	for k, v := range raw {
 		var prettyVal string
-		switch {
-		case strings.HasPrefix(program, "helix-"):
+		switch desc.Syntax {
+		case "toml-array":
 			prettyVal = tomlList(v)
 
-		case program == "micro",
-			program == "lazygit",
-			program == "zellij":
+		case "json-object", "bare":
 			prettyVal = strings.Trim(v, "[]")
 
 		default:
@@ -204,25 +180,26 @@ func tomlList(raw string) string {
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// TODO: pass as config toml
-var programHeaders = map[string][]string{
-	"helix-common": {},
-	"helix-insert": {
-		"[keys.insert]",
-		`A-ret = ["completion"]`,
-	},
-	"helix-normal": {
-		"[keys.normal]",
-		`A-ret = ["hover"]`,
-	},
-	"helix-select": {
-		"[keys.select]",
-		`A-ret = ["hover"]`,
-	},
-	"micro": {
-		`"MouseRight": "MouseMultiCursor",`,
-		`"AltEnter": "Autocomplete",`,
-	},
+// programCfg holds the per-program and per-family descriptors loaded from
+// ~/.babel/config/programs.toml. It replaces the hardcoded programHeaders
+// and families maps that emitConfig/formatBinds used to switch on.
+var programCfg programsConfig
+
+// programDescriptor describes how a single program's config section is
+// rendered: which header lines precede the bindings and which output
+// syntax flavour (toml-array, json-object, bare) formatBinds/emitConfig
+// should use.
+type programDescriptor struct {
+	Syntax  string   `toml:"syntax"`
+	Headers []string `toml:"headers"`
+}
+
+// programsConfig is the shape of programs.toml: a table of program
+// descriptors keyed by target name, plus a table of families mapping a
+// short name (e.g. "helix") to the concrete sub-targets it expands into.
+type programsConfig struct {
+	Programs map[string]programDescriptor `toml:"programs"`
+	Families map[string][]string          `toml:"families"`
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,52 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lspCmd speaks JSON-RPC 2.0 over stdio so editors can treat .edn binding
+// files as a first-class language instead of every user writing their own
+// parser: documentSymbol, definition, and hover all reuse the same
+// extractEntry/decodeMetadata/decodeRule/buildRows path the CLI's display
+// and lint commands already parse with.
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Speak LSP over stdio for .edn binding files",
+	Long:  helpLSP,
+
+	Run: runLSP,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	rootCmd.AddCommand(lspCmd)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func runLSP(cmd *cobra.Command, args []string) {
+	serveLSP(bufio.NewReader(os.Stdin), os.Stdout)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
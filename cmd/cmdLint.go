@@ -0,0 +1,70 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lintCmd walks every parsed BindingEntry looking for the footguns that
+// silently break a large Goku config: duplicate triggers within a program,
+// multi-chord triggers shadowed by a shorter binding, and triggers that
+// still occupy a slot despite resolving to DefaultKey.
+var lintCmd = &cobra.Command{
+	Use:     "lint",
+	Short:   "Report duplicate, shadowed, and default-key bindings",
+	Long:    helpLint,
+	Example: exampleLint,
+
+	Run: runLint,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+
+	lintCmd.Flags().StringVarP(&flags.ednFile, "file", "f", "", "Path to your EDN file")
+	lintCmd.Flags().StringVarP(&flags.displayFormat, "format", "o", "ascii", "Output renderer: ascii (or text), markdown, html, json, csv, yaml, pretty, or sarif")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func runLint(cmd *cobra.Command, args []string) {
+	entries, err := loadAllEntries()
+	if err != nil {
+		log.Fatalf("EDN parsing error: %v", err)
+	}
+
+	issues := lintEntries(entries)
+	if err := renderLintIssues(flags.displayFormat, issues, cmd.OutOrStdout()); err != nil {
+		log.Fatalf("render error: %v", err)
+	}
+
+	if len(issues) > 0 {
+		os.Exit(1)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -17,23 +17,23 @@ along with this program. If not, see <http://www.gnu.org/licenses/>.
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
-	"github.com/edn-format/edn"
-
+	"github.com/DanielRivasMD/Babel/internal/parsec"
+	"github.com/DanielRivasMD/Babel/layouts"
+	"github.com/DanielRivasMD/horus"
 	"github.com/spf13/cobra"
-	"github.com/ttacon/chalk"
+	"olympos.io/encoding/edn"
 )
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // configurable variables
 const (
-	TcPrefix    = "TC"    // change this to modify the EDN prefix
-	DefaultKey  = " "     // display for unmapped keys
+	TcPrefix    = "TC" // change this to modify the EDN prefix
+	DefaultKey  = " "  // display for unmapped keys
 	OutputDir   = "layouts"
 	OutputFile  = "keyboard_layout.md"
 	EdnFilePath = "keyboard_config.edn"
@@ -51,16 +51,12 @@ var ()
 
 // parseednCmd
 var parseednCmd = &cobra.Command{
-	Use:   "parseedn",
-	Short: "" + chalk.Yellow.Color("") + ".",
-	Long: chalk.Green.Color(chalk.Bold.TextStyle("Daniel Rivas ")) + chalk.Dim.TextStyle(chalk.Italic.TextStyle("<danielrivasmd@gmail.com>")) + `
-`,
-
-	Example: `
-` + chalk.Cyan.Color("babel") + ` help ` + chalk.Yellow.Color("") + chalk.Yellow.Color("parseedn"),
-
-	////////////////////////////////////////////////////////////////////////////////////////////////////
+	Use:     "parseedn",
+	Short:   "Render the active TC layer as a keyboard diagram",
+	Long:    helpParseEdn,
+	Example: exampleParseedn,
 
+	RunE: runParseEdn,
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -69,11 +65,78 @@ var parseednCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(parseednCmd)
 
-	// flags
+	parseednCmd.Flags().StringVarP(&flags.ednFile, "file", "f", EdnFilePath, "Path to your EDN file")
+	parseednCmd.Flags().StringVarP(&flags.ednKeyboard, "keyboard", "k", layouts.ANSI.Name, "Keyboard shape for --svg/--png: ansi, iso, or ortholinear")
+	parseednCmd.Flags().StringVarP(&flags.ednUsageLog, "usage-log", "", "", "CSV of key,count keypress totals to heat-tint the diagram")
+	parseednCmd.Flags().StringVarP(&flags.ednSVGOut, "svg", "", "", "Write an SVG keyboard heatmap to this path instead of the Markdown diagram")
+	parseednCmd.Flags().StringVarP(&flags.ednPNGOut, "png", "", "", "Write a PNG keyboard heatmap (rasterized from the SVG) to this path")
+
+	horus.CheckErr(
+		parseednCmd.RegisterFlagCompletionFunc("keyboard", completeKeyboardType),
+		horus.WithOp("parseedn.init"),
+		horus.WithMessage("registering keyboard completion for flag keyboard"),
+	)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// runParseEdn renders the parsed TC layer either as the original ASCII
+// Markdown diagram, or — when --svg/--png is set — as a keyboard heatmap
+// colored by key category and (with --usage-log) keypress frequency. Every
+// failure is returned rather than panicking, so cobra can report it and
+// exit cleanly instead of killing the process mid-render.
+func runParseEdn(cmd *cobra.Command, args []string) error {
+	config, err := parseEdnConfig(flags.ednFile)
+	if err != nil {
+		return err
+	}
+
+	if flags.ednSVGOut == "" && flags.ednPNGOut == "" {
+		return generateMarkdown(config)
+	}
+
+	layout, ok := layouts.Get(flags.ednKeyboard)
+	if !ok {
+		return fmt.Errorf("unsupported --keyboard %q (want %s)", flags.ednKeyboard, strings.Join(layouts.Names(), ", "))
+	}
+
+	usage, err := loadUsageLog(flags.ednUsageLog)
+	if err != nil {
+		return fmt.Errorf("reading --usage-log: %w", err)
+	}
+
+	svg := renderHeatmapSVG(config, layout, usage)
+
+	if flags.ednSVGOut != "" {
+		if err := os.WriteFile(flags.ednSVGOut, []byte(svg), 0644); err != nil {
+			return fmt.Errorf("writing --svg: %w", err)
+		}
+	}
+
+	if flags.ednPNGOut != "" {
+		png, err := renderHeatmapPNG(svg)
+		if err != nil {
+			return fmt.Errorf("rendering --png: %w", err)
+		}
+		if err := os.WriteFile(flags.ednPNGOut, png, 0644); err != nil {
+			return fmt.Errorf("writing --png: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func completeKeyboardType(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var completions []string
+	for _, name := range layouts.Names() {
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // func main() {
 // 	config := parseEdnConfig(EdnFilePath)
@@ -82,15 +145,18 @@ func init() {
 // 	fmt.Printf("Output: %s/%s\n", OutputDir, OutputFile)
 // }
 
-func parseEdnConfig(filePath string) KeyboardConfig {
+// parseEdnConfig streams the ":rules" vector of an EDN file rule-by-rule via
+// parsec.StreamRules, instead of unmarshalling the whole file into a
+// map[string]interface{} up front. Each rule's key keyword (e.g.
+// "!TC#Pa", "!TC#Popen_bracket") is decoded once with
+// parsec.ParseKeyDescriptor rather than matched against a
+// fmt.Sprintf(":!%s#P%s", TcPrefix, letter) candidate for every letter of
+// the alphabet. Every failure site is wrapped with horus.NewHerror instead
+// of panicking, so callers (e.g. a future `babel convert`) can recover.
+func parseEdnConfig(filePath string) (KeyboardConfig, error) {
 	file, err := os.ReadFile(filePath)
 	if err != nil {
-		panic(fmt.Sprintf("Error reading EDN file: %v", err))
-	}
-
-	var data map[string]interface{}
-	if err := edn.Unmarshal(file, &data); err != nil {
-		panic(fmt.Sprintf("Error parsing EDN: %v", err))
+		return KeyboardConfig{}, horus.NewHerror("parseedn.readFile", "reading EDN file", err, map[string]any{"path": filePath})
 	}
 
 	config := KeyboardConfig{
@@ -120,49 +186,44 @@ func parseEdnConfig(filePath string) KeyboardConfig {
 	config.SpecialKeys["right_command"] = "CMD"
 	config.SpecialKeys["spacebar"] = "SPACE"
 
-	// parse rules
-	rules, ok := data[":rules"].([]interface{})
-	if !ok {
-		return config
-	}
-
-	for _, rule := range rules {
-		ruleList, ok := rule.([]interface{})
-		if !ok || len(ruleList) < 2 {
-			continue
+	var streamErr error
+	parsec.StreamRules(string(file), func(rule string, pos parsec.Position) bool {
+		vec, err := decodeRule(rule)
+		if err != nil {
+			streamErr = horus.NewHerror("parseedn.unmarshal", "decoding rule form", err, map[string]any{"path": filePath, "pos": pos.String()})
+			return false // genuinely malformed EDN: stop streaming
+		}
+		if len(vec) < 2 {
+			return true // well-formed but not a "[key value]" rule: skip
 		}
 
-		key, ok := ruleList[0].(edn.Keyword)
+		key, ok := vec[0].(edn.Keyword)
 		if !ok {
-			continue
+			return true
 		}
 
-		keyStr := string(key)
-		value := ruleList[1]
-
-		// handle letter keys (a-z)
-		for c := 'a'; c <= 'z'; c++ {
-			letter := string(c)
-			if keyStr == fmt.Sprintf(":!%s#P%s", TcPrefix, letter) {
-				config.Letters[letter] = formatEdnValue(value)
-				break
-			}
+		kd, _, err := parsec.ParseKeyDescriptor(string(key))
+		if err != nil || kd.Prefix != TcPrefix {
+			return true // not a "!<TcPrefix>#P<key>" rule, or wrong prefix
 		}
+		_ = pos // reserved for a future --debug file:line report
 
-		// handle special keys
-		switch keyStr {
-		case fmt.Sprintf(":!%s#Popen_bracket", TcPrefix):
-			config.SpecialKeys["open_bracket"] = formatEdnValue(value)
-		case fmt.Sprintf(":!%s#Pclose_bracket", TcPrefix):
-			config.SpecialKeys["close_bracket"] = formatEdnValue(value)
-		// Add other special key cases...
-		case fmt.Sprintf(":!%s#Pdelete_or_backspace", TcPrefix):
-			config.SpecialKeys["delete_or_backspace"] = formatEdnValue(value)
+		value := formatEdnValue(vec[1])
+		if len(kd.Key) == 1 && kd.Key[0] >= 'a' && kd.Key[0] <= 'z' {
+			config.Letters[kd.Key] = value
+			return true
+		}
+		if _, ok := config.SpecialKeys[kd.Key]; ok {
+			config.SpecialKeys[kd.Key] = value
 		}
+		return true
+	})
+	if streamErr != nil {
+		return KeyboardConfig{}, streamErr
 	}
 
 	config.UsedTcPrefix = TcPrefix
-	return config
+	return config, nil
 }
 
 func formatEdnValue(value interface{}) string {
@@ -180,15 +241,17 @@ func formatEdnValue(value interface{}) string {
 	}
 }
 
-func generateMarkdown(config KeyboardConfig) {
+func generateMarkdown(config KeyboardConfig) error {
+	outputPath := fmt.Sprintf("%s/%s", OutputDir, OutputFile)
+
 	// create output directory
 	if err := os.MkdirAll(OutputDir, 0755); err != nil {
-		panic(fmt.Sprintf("Error creating output directory: %v", err))
+		return horus.NewHerror("parseedn.writeLayout", "creating output directory", err, map[string]any{"path": OutputDir})
 	}
 
-	file, err := os.Create(fmt.Sprintf("%s/%s", OutputDir, OutputFile))
+	file, err := os.Create(outputPath)
 	if err != nil {
-		panic(fmt.Sprintf("Error creating output file: %v", err))
+		return horus.NewHerror("parseedn.writeLayout", "creating output file", err, map[string]any{"path": outputPath})
 	}
 	defer file.Close()
 
@@ -207,7 +270,7 @@ func generateMarkdown(config KeyboardConfig) {
 
 `+"```markdown"+`
 ┌─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬───────────┐
-| ~ ` | ! 1 | @ 2 | # 3 | $ 4 | %% 5 | ^ 6 | & 7 | * 8 | ( 9 | ) 0 | _ - | + = | %s |
+| ~ `+"`"+` | ! 1 | @ 2 | # 3 | $ 4 | %% 5 | ^ 6 | & 7 | * 8 | ( 9 | ) 0 | _ - | + = | %s |
 | TAB | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s |
 | CAPS | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s |      %s      |
 | SHIFT  | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s |     %s     |
@@ -242,7 +305,10 @@ func generateMarkdown(config KeyboardConfig) {
 		config.UsedTcPrefix,
 	)
 
-	file.WriteString(content)
+	if _, err := file.WriteString(content); err != nil {
+		return horus.NewHerror("parseedn.writeLayout", "writing output file", err, map[string]any{"path": outputPath})
+	}
+	return nil
 }
 
 func getActiveMappings(letters map[string]string) string {
@@ -0,0 +1,87 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"log"
+	"os"
+
+	"github.com/DanielRivasMD/Babel/internal/bindings"
+	"github.com/DanielRivasMD/horus"
+	"github.com/spf13/cobra"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// parsejsonCmd reads a karabiner.json complex_modifications document and
+// either displays it as a table (like displayCmd does for EDN) or emits it
+// back out as EDN, so bindings authored in JSON round-trip through babel.
+var parsejsonCmd = &cobra.Command{
+	Use:     "parsejson",
+	Short:   "Display or convert a karabiner.json complex_modifications file",
+	Long:    helpParseJSON,
+	Example: exampleParseJSON,
+
+	PreRun: preParseJSON,
+	Run:    runParseJSON,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	rootCmd.AddCommand(parsejsonCmd)
+
+	parsejsonCmd.Flags().StringVarP(&flags.jsonFile, "file", "f", "", "Path to a karabiner.json file")
+	parsejsonCmd.Flags().BoolVarP(&flags.jsonEcho, "edn", "", false, "Emit the parsed bindings as EDN instead of a table")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func preParseJSON(cmd *cobra.Command, args []string) {
+	horus.CheckEmpty(
+		flags.jsonFile,
+		"",
+		horus.WithMessage("`--file` is required"),
+		horus.WithExitCode(2),
+		horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func runParseJSON(cmd *cobra.Command, args []string) {
+	data, err := os.ReadFile(flags.jsonFile)
+	if err != nil {
+		log.Fatalf("failed to open %q: %v", flags.jsonFile, err)
+	}
+
+	entries, err := bindings.DecodeKarabiner(data)
+	if err != nil {
+		log.Fatalf("failed to decode %q: %v", flags.jsonFile, err)
+	}
+
+	if flags.jsonEcho {
+		os.Stdout.WriteString(bindings.EncodeEDN(entries))
+		return
+	}
+
+	emitTable(fromBindingEntries(entries))
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,213 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/DanielRivasMD/horus"
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var watchCmd = &cobra.Command{
+	Use:     "watch",
+	Short:   "Regenerate configs whenever EDN sources change",
+	Long:    helpWatch,
+	Example: exampleWatch,
+
+	PreRun: preWatch,
+	Run:    runWatch,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// watchDebounce bounds how long a burst of EDN writes is collapsed into a
+// single regenerate pass.
+const watchDebounce = 200 * time.Millisecond
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().StringToStringVarP(&flags.targets, "targets", "", nil, "program=path pairs to (re)write on every EDN change, e.g. helix-normal=~/.config/helix/config.toml")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func preWatch(cmd *cobra.Command, args []string) {
+	horus.CheckEmpty(
+		flags.rootDir,
+		"",
+		horus.WithMessage("`--root` is required"),
+		horus.WithExitCode(2),
+		horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+	)
+	if len(flags.targets) == 0 {
+		horus.CheckErr(
+			errors.New("no targets configured"),
+			horus.WithMessage("`--targets` is required"),
+			horus.WithExitCode(2),
+			horus.WithFormatter(func(he *horus.Herror) string { return onelineErr(he.Message) }),
+		)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func runWatch(cmd *cobra.Command, args []string) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Fatalf("failed to start EDN watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	if err := watchEDNDirs(watcher, flags.rootDir); err != nil {
+		log.Fatalf("failed to watch %s: %v", flags.rootDir, err)
+	}
+
+	var previous []BindingEntry
+	regenerate := func() {
+		paths := resolveEDNFiles(flags.ednFile, flags.rootDir)
+		entries, err := parseEDNFiles(paths)
+		if err != nil {
+			log.Printf("EDN parsing error: %v", err)
+			return
+		}
+
+		if flags.verbose {
+			logBindingDiff(previous, entries)
+		}
+		previous = entries
+
+		for program, target := range flags.targets {
+			if err := rewriteConfigAtomic(target, entries, program); err != nil {
+				log.Printf("failed to rewrite %s: %v", target, err)
+			}
+		}
+	}
+
+	regenerate()
+
+	var timer *time.Timer
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if !strings.HasSuffix(event.Name, ".edn") {
+				continue
+			}
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.AfterFunc(watchDebounce, regenerate)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("watch error: %v", err)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// watchEDNDirs registers root and every directory beneath it, since
+// fsnotify only watches the directories it is explicitly given.
+func watchEDNDirs(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return watcher.Add(path)
+		}
+		return nil
+	})
+}
+
+// rewriteConfigAtomic renders target's config to a temp file alongside the
+// destination, then renames it into place so Helix (or any other watcher)
+// never observes a half-written config.
+func rewriteConfigAtomic(target string, entries []BindingEntry, program string) error {
+	tmp := target + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	emitConfig(f, entries, program)
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, target)
+}
+
+// logBindingDiff reports which bindings were added or removed between two
+// parses, so --verbose explains what triggered a rewrite.
+func logBindingDiff(before, after []BindingEntry) {
+	added, removed := 0, 0
+
+	seenBefore := make(map[string]BindingEntry, len(before))
+	for _, e := range before {
+		seenBefore[bindingDiffKey(e)] = e
+	}
+	seenAfter := make(map[string]bool, len(after))
+	for _, e := range after {
+		key := bindingDiffKey(e)
+		seenAfter[key] = true
+		if old, ok := seenBefore[key]; !ok || !reflect.DeepEqual(old, e) {
+			added++
+		}
+	}
+	for key := range seenBefore {
+		if !seenAfter[key] {
+			removed++
+		}
+	}
+
+	if added == 0 && removed == 0 {
+		log.Printf("no binding changes detected")
+		return
+	}
+	log.Printf("binding diff: %d changed/added, %d removed", added, removed)
+}
+
+// bindingDiffKey identifies a BindingEntry independent of map ordering so
+// two parses of semantically identical EDN compare equal.
+func bindingDiffKey(e BindingEntry) string {
+	return fmt.Sprintf("%s %s %s", e.Trigger, e.Binding, e.Sequence)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,110 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+*/
+
+// Command gen is a packaging-time generator, run via `go run ./cmd/gen`:
+// it walks the babel command tree and emits shell completion scripts,
+// man(1) pages, and Markdown flag docs for every registered subcommand,
+// so distro maintainers have a real artifact pipeline instead of users
+// discovering flag values (e.g. --render's empty/full/default) by reading
+// source.
+package main
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/DanielRivasMD/Babel/cmd"
+	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func main() {
+	completionDir := flag.String("completion-dir", "dist/completions", "Directory to write bash/zsh/fish completion scripts to")
+	manDir := flag.String("man-dir", "dist/man", "Directory to write man(1) pages to")
+	docDir := flag.String("doc-dir", "dist/docs", "Directory to write Markdown flag docs to")
+	flag.Parse()
+
+	root := cmd.RootCmd()
+
+	if err := genCompletions(root, *completionDir); err != nil {
+		fmt.Fprintln(os.Stderr, "gen: completions:", err)
+		os.Exit(1)
+	}
+	if err := genManPages(root, *manDir); err != nil {
+		fmt.Fprintln(os.Stderr, "gen: man pages:", err)
+		os.Exit(1)
+	}
+	if err := genMarkdownDocs(root, *docDir); err != nil {
+		fmt.Fprintln(os.Stderr, "gen: markdown docs:", err)
+		os.Exit(1)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// genCompletions emits bash, zsh, and fish completion scripts. The
+// per-flag completers registered via RegisterFlagCompletionFunc (render,
+// sort, format, program) don't need any extra wiring here: cobra's
+// generated scripts already shell back out to the babel binary itself to
+// resolve dynamic flag values at complete-time.
+func genCompletions(root *cobra.Command, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	if err := root.GenBashCompletionFile(dir + "/babel.bash"); err != nil {
+		return err
+	}
+	if err := root.GenZshCompletionFile(dir + "/babel.zsh"); err != nil {
+		return err
+	}
+	if err := root.GenFishCompletionFile(dir+"/babel.fish", true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// genManPages emits a man(1) page per command in the tree rooted at root.
+// "key" is absent here because cmd/cmdKey.go predates displayCmd's
+// Renderer-based --format plumbing, redeclares symbols already owned by
+// cmdDisplay.go/utilIO.go/utilParse.go, and isn't wired into rootCmd; there
+// is no live "key" command for GenManTree to document.
+func genManPages(root *cobra.Command, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	header := &doc.GenManHeader{
+		Title:   "BABEL",
+		Section: "1",
+		Source:  "Babel",
+		Manual:  "Babel Manual",
+	}
+	return doc.GenManTree(root, header, dir)
+}
+
+// genMarkdownDocs emits one Markdown page per command, indexing its flags.
+func genMarkdownDocs(root *cobra.Command, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return doc.GenMarkdownTree(root, dir)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,80 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"io"
+	"sort"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Renderer turns a set of parsed bindings into bytes on w. displayCmd picks
+// one via --format; each implementation is free to lay the same rows out
+// however suits its target (terminal, browser, pipe).
+type Renderer interface {
+	Render(entries []BindingEntry, w io.Writer) error
+}
+
+var renderers = map[string]Renderer{
+	"ascii":    asciiRenderer{},
+	"markdown": markdownRenderer{},
+	"html":     htmlRenderer{},
+	"json":     jsonRenderer{},
+	"csv":      csvRenderer{},
+	"yaml":     yamlRenderer{},
+	"pretty":   prettyRenderer{},
+}
+
+func lookupRenderer(format string) (Renderer, bool) {
+	r, ok := renderers[strings.ToLower(format)]
+	return r, ok
+}
+
+// rowRenderers is the row-level half of renderers: every Renderer.Render
+// ultimately calls buildRows then one of these, and callers that already
+// have tableRows from somewhere other than BindingEntry (lintCmd) can skip
+// straight to this layer.
+var rowRenderers = map[string]func([]tableRow, io.Writer) error{
+	"ascii":    renderAsciiRows,
+	"markdown": renderMarkdownRows,
+	"html":     renderHTMLRows,
+	"json":     renderJSONRows,
+	"csv":      renderCSVRows,
+	"yaml":     renderYAMLRows,
+	"pretty":   renderPrettyRows,
+}
+
+func lookupRowRenderer(format string) (func([]tableRow, io.Writer) error, bool) {
+	r, ok := rowRenderers[strings.ToLower(format)]
+	return r, ok
+}
+
+// rendererNames lists registered formats, sorted, for shell completion.
+func rendererNames() []string {
+	names := make([]string, 0, len(renderers))
+	for name := range renderers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,75 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ttacon/chalk"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// asciiRenderer is the original fixed-width table, kept as the default so
+// piping to a plain terminal looks the same as before --format existed.
+type asciiRenderer struct{}
+
+func (asciiRenderer) Render(entries []BindingEntry, w io.Writer) error {
+	return renderAsciiRows(buildRows(entries), w)
+}
+
+// renderAsciiRows is the row-level half of asciiRenderer, factored out so
+// lintCmd can reuse the same table layout for rows that didn't come from
+// buildRows.
+func renderAsciiRows(rows []tableRow, w io.Writer) error {
+	if len(rows) == 0 {
+		fmt.Fprintln(w, "No bindings found.")
+		return nil
+	}
+
+	fmt.Fprintln(w, tableBorder)
+	fmt.Fprintln(w, tableHeader)
+	fmt.Fprintln(w, tableDivider)
+
+	for _, r := range rows {
+		var progColor *chalk.Color
+		if c, ok := programColors[r.Program]; ok {
+			progColor = &c
+		}
+
+		row := fmt.Sprintf("| %s | %s | %s | %s |\n",
+			renderCell(r.Program, 12, progColor),
+			renderCell(r.Action, 30, nil),
+			renderCell(r.Trigger, 20, nil),
+			renderCell(r.Binding, 20, nil),
+		)
+
+		if r.Empty {
+			row = chalk.Dim.TextStyle(row)
+		}
+
+		fmt.Fprint(w, row)
+	}
+
+	fmt.Fprintln(w, tableBorder)
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
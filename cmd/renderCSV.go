@@ -0,0 +1,52 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// csvRenderer emits the table as RFC 4180 CSV, for spreadsheets and other
+// tools that don't speak NDJSON.
+type csvRenderer struct{}
+
+func (csvRenderer) Render(entries []BindingEntry, w io.Writer) error {
+	return renderCSVRows(buildRows(entries), w)
+}
+
+// renderCSVRows is the row-level half of csvRenderer, factored out so
+// lintCmd can reuse it for rows that didn't come from buildRows.
+func renderCSVRows(rows []tableRow, w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"Program", "Action", "Trigger", "Binding"}); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if err := cw.Write([]string{r.Program, r.Action, r.Trigger, r.Binding}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
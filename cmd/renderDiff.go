@@ -0,0 +1,214 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/DanielRivasMD/Babel/internal/bindings"
+	"github.com/DanielRivasMD/Babel/internal/diff"
+	"github.com/DanielRivasMD/Babel/layouts"
+	"github.com/ttacon/chalk"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// renderDiffText renders changes as a unified, git-diff-like listing: one
+// -/+/~ line per Trigger, with a nested -/+ line per changed action.
+func renderDiffText(changes []diff.Change, w io.Writer) error {
+	for _, c := range changes {
+		switch c.Kind {
+		case diff.Added:
+			fmt.Fprintf(w, "+ %s -> %s\n", triggerString(c.Trigger), bindingString(c.After.Binding))
+		case diff.Removed:
+			fmt.Fprintf(w, "- %s -> %s\n", triggerString(c.Trigger), bindingString(c.Before.Binding))
+		case diff.Modified:
+			fmt.Fprintf(w, "~ %s\n", triggerString(c.Trigger))
+			if c.Before.Binding != c.After.Binding {
+				fmt.Fprintf(w, "  - binding %s\n", bindingString(c.Before.Binding))
+				fmt.Fprintf(w, "  + binding %s\n", bindingString(c.After.Binding))
+			}
+			for _, a := range c.Actions {
+				fmt.Fprintf(w, "  %s\n", actionChangeString(a))
+			}
+		}
+	}
+	return nil
+}
+
+// triggerString and bindingString both format a bindings.Trigger, used for
+// the pressed chord and the bound-to chord respectively — same type, two
+// names so renderDiffText's output reads as "<trigger> -> <binding>".
+func triggerString(t bindings.Trigger) string {
+	if t.Mode == "" {
+		return fmt.Sprintf(":!%s%s", t.Modifier, t.Key)
+	}
+	return fmt.Sprintf(":!%s#%s%s", t.Mode, t.Modifier, t.Key)
+}
+
+func bindingString(t bindings.Trigger) string {
+	return fmt.Sprintf(":!%s%s", t.Modifier, t.Key)
+}
+
+// actionChangeString formats one action-level change within a modified
+// Trigger: added, removed, or (both sides present) a command change.
+func actionChangeString(a diff.ActionChange) string {
+	switch {
+	case a.Before == nil:
+		return fmt.Sprintf("+ action %s/%s -> %s", a.After.Program, a.After.Action, a.After.Command)
+	case a.After == nil:
+		return fmt.Sprintf("- action %s/%s -> %s", a.Before.Program, a.Before.Action, a.Before.Command)
+	default:
+		return fmt.Sprintf("~ action %s/%s: %s -> %s", a.Before.Program, a.Before.Action, a.Before.Command, a.After.Command)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// renderDiffJSON renders changes as a machine-readable report of added,
+// removed, and modified triggers plus their per-action changes.
+func renderDiffJSON(changes []diff.Change, w io.Writer) error {
+	type actionChangeJSON struct {
+		Program string `json:"program"`
+		Action  string `json:"action"`
+		Before  string `json:"before,omitempty"`
+		After   string `json:"after,omitempty"`
+	}
+	type changeJSON struct {
+		Kind        string             `json:"kind"`
+		Mode        string             `json:"mode"`
+		Modifier    string             `json:"modifier"`
+		Key         string             `json:"key"`
+		Before      string             `json:"before,omitempty"`
+		After       string             `json:"after,omitempty"`
+		ActionDiffs []actionChangeJSON `json:"actions,omitempty"`
+	}
+
+	report := make([]changeJSON, 0, len(changes))
+	for _, c := range changes {
+		cj := changeJSON{
+			Kind:     string(c.Kind),
+			Mode:     c.Trigger.Mode,
+			Modifier: c.Trigger.Modifier,
+			Key:      c.Trigger.Key,
+		}
+		if c.Before != nil {
+			cj.Before = bindingString(c.Before.Binding)
+		}
+		if c.After != nil {
+			cj.After = bindingString(c.After.Binding)
+		}
+		for _, a := range c.Actions {
+			acj := actionChangeJSON{}
+			if a.Before != nil {
+				acj.Program, acj.Action, acj.Before = a.Before.Program, a.Before.Action, a.Before.Command
+			}
+			if a.After != nil {
+				acj.Program, acj.Action, acj.After = a.After.Program, a.After.Action, a.After.Command
+			}
+			cj.ActionDiffs = append(cj.ActionDiffs, acj)
+		}
+		report = append(report, cj)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// renderDiffMarkdown renders changes as a side-by-side keyboard diagram: one
+// markdown table row per physical row of keyboardName, each cell colored by
+// whether its Trigger.Key was added, removed, or modified. Multi-chord and
+// modifier-qualified triggers all collapse onto the bare key they share a
+// physical position with, since a keyboard diagram has one cell per key.
+func renderDiffMarkdown(changes []diff.Change, keyboardName string, w io.Writer) error {
+	layout, ok := layouts.Get(keyboardName)
+	if !ok {
+		return fmt.Errorf("unsupported --keyboard %q (want %s)", keyboardName, strings.Join(layouts.Names(), ", "))
+	}
+
+	byKey := make(map[string]diff.Change, len(changes))
+	for _, c := range changes {
+		byKey[c.Trigger.Key] = c
+	}
+
+	fmt.Fprintf(w, "# Binding diff (%s)\n\n", layout.Name)
+	for _, row := range diffLayoutRows(layout) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = diffCellLabel(cell.Label, byKey)
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Legend: %s %s %s\n",
+		chalk.Bold.TextStyle(chalk.Green.Color("+added")),
+		chalk.Bold.TextStyle(chalk.Red.Color("-removed")),
+		chalk.Bold.TextStyle(chalk.Yellow.Color("~modified")),
+	)
+	return nil
+}
+
+// diffLayoutRows groups layout's Cells by physical row, each sorted
+// left-to-right by column, so renderDiffMarkdown can print one markdown
+// table row per keyboard row.
+func diffLayoutRows(layout layouts.Layout) [][]layouts.Cell {
+	byRow := make(map[float64][]layouts.Cell)
+	var rowKeys []float64
+	for _, cell := range layout.Cells {
+		if _, seen := byRow[cell.Row]; !seen {
+			rowKeys = append(rowKeys, cell.Row)
+		}
+		byRow[cell.Row] = append(byRow[cell.Row], cell)
+	}
+
+	sort.Float64s(rowKeys)
+	rows := make([][]layouts.Cell, len(rowKeys))
+	for i, r := range rowKeys {
+		cells := byRow[r]
+		sort.Slice(cells, func(a, b int) bool { return cells[a].Col < cells[b].Col })
+		rows[i] = cells
+	}
+	return rows
+}
+
+func diffCellLabel(label string, byKey map[string]diff.Change) string {
+	c, ok := byKey[label]
+	if !ok {
+		return label
+	}
+	switch c.Kind {
+	case diff.Added:
+		return chalk.Bold.TextStyle(chalk.Green.Color("+" + label))
+	case diff.Removed:
+		return chalk.Bold.TextStyle(chalk.Red.Color("-" + label))
+	case diff.Modified:
+		return chalk.Bold.TextStyle(chalk.Yellow.Color("~" + label))
+	default:
+		return label
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,96 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"html"
+	"io"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// htmlRenderer emits a standalone HTML table, syntax-highlighting the
+// Binding column with chroma's closest EDN relative (Clojure) since chroma
+// ships no dedicated EDN lexer.
+type htmlRenderer struct{}
+
+var (
+	bindingLexer     = chroma.Coalesce(lexerOrFallback("clojure"))
+	bindingStyle     = styles.Get("monokai")
+	bindingFormatter = chromahtml.New(chromahtml.WithClasses(false))
+)
+
+func lexerOrFallback(name string) chroma.Lexer {
+	if l := lexers.Get(name); l != nil {
+		return l
+	}
+	return lexers.Fallback
+}
+
+func (htmlRenderer) Render(entries []BindingEntry, w io.Writer) error {
+	return renderHTMLRows(buildRows(entries), w)
+}
+
+// renderHTMLRows is the row-level half of htmlRenderer, factored out so
+// lintCmd can reuse it for rows that didn't come from buildRows.
+func renderHTMLRows(rows []tableRow, w io.Writer) error {
+	fmt.Fprintln(w, "<table>")
+	fmt.Fprintln(w, "<thead><tr><th>Program</th><th>Action</th><th>Trigger</th><th>Binding</th></tr></thead>")
+	fmt.Fprintln(w, "<tbody>")
+
+	for _, r := range rows {
+		fmt.Fprintf(w, "<tr%s>", rowClass(r.Empty))
+		fmt.Fprintf(w, "<td>%s</td>", html.EscapeString(r.Program))
+		fmt.Fprintf(w, "<td>%s</td>", html.EscapeString(r.Action))
+		fmt.Fprintf(w, "<td>%s</td>", html.EscapeString(r.Trigger))
+		fmt.Fprint(w, "<td>")
+		if err := highlightBinding(w, r.Binding); err != nil {
+			return err
+		}
+		fmt.Fprintln(w, "</td></tr>")
+	}
+
+	fmt.Fprintln(w, "</tbody>")
+	fmt.Fprintln(w, "</table>")
+	return nil
+}
+
+func rowClass(empty bool) string {
+	if empty {
+		return ` class="empty"`
+	}
+	return ""
+}
+
+func highlightBinding(w io.Writer, binding string) error {
+	iterator, err := bindingLexer.Tokenise(nil, binding)
+	if err != nil {
+		fmt.Fprint(w, html.EscapeString(binding))
+		return nil
+	}
+	return bindingFormatter.Format(w, bindingStyle, iterator)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,49 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"encoding/json"
+	"io"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// jsonRenderer emits one compact JSON object per row (NDJSON), so the
+// output can be piped straight into jq or another line-oriented tool
+// without holding the whole table in memory.
+type jsonRenderer struct{}
+
+func (jsonRenderer) Render(entries []BindingEntry, w io.Writer) error {
+	return renderJSONRows(buildRows(entries), w)
+}
+
+// renderJSONRows is the row-level half of jsonRenderer, factored out so
+// lintCmd can reuse it for rows that didn't come from buildRows.
+func renderJSONRows(rows []tableRow, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, r := range rows {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,88 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/muesli/termenv"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// markdownRenderer builds a real Markdown table, then lets glamour
+// re-render it for the terminal, auto-picking a light/dark theme off the
+// caller's background.
+type markdownRenderer struct{}
+
+func (markdownRenderer) Render(entries []BindingEntry, w io.Writer) error {
+	return renderMarkdownRows(buildRows(entries), w)
+}
+
+// renderMarkdownRows is the row-level half of markdownRenderer, factored
+// out so lintCmd can reuse it for rows that didn't come from buildRows.
+func renderMarkdownRows(rows []tableRow, w io.Writer) error {
+	if len(rows) == 0 {
+		fmt.Fprintln(w, "No bindings found.")
+		return nil
+	}
+
+	var md strings.Builder
+	md.WriteString("| Program | Action | Trigger | Binding |\n")
+	md.WriteString("|---|---|---|---|\n")
+	for _, r := range rows {
+		fmt.Fprintf(&md, "| %s | %s | %s | %s |\n",
+			escapeMarkdownCell(r.Program),
+			escapeMarkdownCell(r.Action),
+			escapeMarkdownCell(r.Trigger),
+			escapeMarkdownCell(r.Binding),
+		)
+	}
+
+	style := "light"
+	if termenv.HasDarkBackground() {
+		style = "dark"
+	}
+
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(style),
+		glamour.WithWordWrap(0),
+	)
+	if err != nil {
+		return fmt.Errorf("build markdown renderer: %w", err)
+	}
+
+	out, err := renderer.Render(md.String())
+	if err != nil {
+		return fmt.Errorf("render markdown: %w", err)
+	}
+
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// escapeMarkdownCell keeps a stray "|" from splitting a table cell.
+func escapeMarkdownCell(val string) string {
+	return strings.ReplaceAll(val, "|", `\|`)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,99 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/mattn/go-isatty"
+	"github.com/muesli/termenv"
+	"github.com/ttacon/chalk"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// prettyRenderer is markdownRenderer's syntax-highlighted sibling: it colors
+// the Trigger and Binding columns before handing the table to glamour. When
+// w isn't an interactive terminal (piped to a file or another program) it
+// falls back to plain markdownRenderer instead, since ANSI escapes only
+// make sense on a real terminal.
+type prettyRenderer struct{}
+
+func (prettyRenderer) Render(entries []BindingEntry, w io.Writer) error {
+	return renderPrettyRows(buildRows(entries), w)
+}
+
+// renderPrettyRows is the row-level half of prettyRenderer, factored out so
+// lintCmd can reuse it for rows that didn't come from buildRows.
+func renderPrettyRows(rows []tableRow, w io.Writer) error {
+	if !isInteractive(w) {
+		return renderMarkdownRows(rows, w)
+	}
+
+	if len(rows) == 0 {
+		fmt.Fprintln(w, "No bindings found.")
+		return nil
+	}
+
+	var md strings.Builder
+	md.WriteString("| Program | Action | Trigger | Binding |\n")
+	md.WriteString("|---|---|---|---|\n")
+	for _, r := range rows {
+		fmt.Fprintf(&md, "| %s | %s | %s | %s |\n",
+			escapeMarkdownCell(r.Program),
+			escapeMarkdownCell(r.Action),
+			chalk.Cyan.Color(escapeMarkdownCell(r.Trigger)),
+			chalk.Yellow.Color(escapeMarkdownCell(r.Binding)),
+		)
+	}
+
+	style := "light"
+	if termenv.HasDarkBackground() {
+		style = "dark"
+	}
+
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(style),
+		glamour.WithWordWrap(0),
+	)
+	if err != nil {
+		return fmt.Errorf("build pretty renderer: %w", err)
+	}
+
+	out, err := renderer.Render(md.String())
+	if err != nil {
+		return fmt.Errorf("render pretty: %w", err)
+	}
+
+	_, err = io.WriteString(w, out)
+	return err
+}
+
+// isInteractive reports whether w is a terminal, so callers can fall back
+// to a plain renderer when output is piped or redirected to a file.
+func isInteractive(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && isatty.IsTerminal(f.Fd())
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,139 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"encoding/json"
+	"io"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// The sarif* types below are a deliberately minimal slice of the SARIF
+// 2.1.0 object model: one tool, one run, one result per lintIssue. Enough
+// for an editor (or a pre-commit hook) to anchor a diagnostic to a file
+// and line; not an attempt at full spec coverage.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// sarifLevel maps lintSeverity onto the three levels SARIF consumers
+// recognize.
+func sarifLevel(s lintSeverity) string {
+	if s == lintError {
+		return "error"
+	}
+	return "warning"
+}
+
+// renderSarifIssues encodes issues as a single-run SARIF 2.1.0 log, for
+// editors and pre-commit hooks that consume SARIF to surface findings
+// inline rather than parsing lint's plain-text output.
+func renderSarifIssues(issues []lintIssue, w io.Writer) error {
+	seenRules := make(map[string]bool)
+	var rules []sarifRule
+	results := make([]sarifResult, len(issues))
+
+	for i, iss := range issues {
+		if !seenRules[iss.Rule] {
+			seenRules[iss.Rule] = true
+			rules = append(rules, sarifRule{ID: iss.Rule})
+		}
+
+		result := sarifResult{
+			RuleID:  iss.Rule,
+			Level:   sarifLevel(iss.Severity),
+			Message: sarifMessage{Text: iss.Message},
+		}
+		if iss.Path != "" {
+			result.Locations = []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: iss.Path},
+					Region:           sarifRegion{StartLine: iss.Line},
+				},
+			}}
+		}
+		results[i] = result
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/main/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: sarifDriver{Name: "babel-lint", Rules: rules}},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
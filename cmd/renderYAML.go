@@ -0,0 +1,46 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"io"
+
+	"go.yaml.in/yaml/v3"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// yamlRenderer emits the whole table as a single YAML sequence document,
+// for callers that want the table loaded back into a typed struct rather
+// than parsed line-by-line like jsonRenderer's NDJSON.
+type yamlRenderer struct{}
+
+func (yamlRenderer) Render(entries []BindingEntry, w io.Writer) error {
+	return renderYAMLRows(buildRows(entries), w)
+}
+
+// renderYAMLRows is the row-level half of yamlRenderer, factored out so
+// lintCmd can reuse it for rows that didn't come from buildRows.
+func renderYAMLRows(rows []tableRow, w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	defer enc.Close()
+	return enc.Encode(rows)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -22,6 +22,8 @@ import (
 	"path/filepath"
 	"regexp"
 
+	"github.com/DanielRivasMD/Babel/emitters"
+	"github.com/DanielRivasMD/Babel/internal/parsec"
 	"github.com/DanielRivasMD/domovoi"
 	"github.com/DanielRivasMD/horus"
 	"github.com/spf13/cobra"
@@ -42,6 +44,13 @@ func Execute() {
 	horus.CheckErr(rootCmd.Execute())
 }
 
+// RootCmd exposes the root *cobra.Command so external tooling (the
+// cmd/gen artifacts generator) can walk the full, already-registered
+// command tree without rebuilding it.
+func RootCmd() *cobra.Command {
+	return rootCmd
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 var rg = map[string]*regexp.Regexp{
@@ -71,12 +80,56 @@ type babelFlags struct {
 	program string
 
 	// display
-	ednFile    string
-	renderMode string
-	sortBy     string
+	ednFile       string
+	renderMode    string
+	sortBy        string
+	treeMarkdown  bool
+	displayFormat string
+	displayWatch  bool
+	displayLint   bool
+	displayPick   bool
+
+	// interpret
+	interpretTarget string
 
 	// embed
-	embedTarget string
+	embedTarget     string
+	embedExternal   bool
+	embedDryRun     bool
+	embedDiff       bool
+	embedFenceStyle string
+	embedNoFence    bool
+	embedWatch      bool
+
+	// watch
+	targets map[string]string
+
+	// doc
+	docInput  string
+	docOutput string
+	docFormat string
+
+	// convert
+	convertFrom   string
+	convertTo     string
+	convertOutput string
+	convertTitle  string
+
+	// parsejson
+	jsonFile string
+	jsonEcho bool
+
+	// parseedn
+	ednKeyboard string
+	ednUsageLog string
+	ednSVGOut   string
+	ednPNGOut   string
+
+	// diff
+	diffOld      string
+	diffNew      string
+	diffFormat   string
+	diffKeyboard string
 }
 
 type lookUps struct {
@@ -115,6 +168,8 @@ func initConfigDirs() {
 	lookups.displayTrigger = buildLookupFuncs(loadFormat(filepath.Join(dirs.config, "display_trigger.toml")))
 	lookups.interpret = buildLookupFuncs(loadFormat(filepath.Join(dirs.config, "interpret.toml")))
 	lookups.embed = buildLookupFuncs(loadFormat(filepath.Join(dirs.config, "embed.toml")))
+
+	programCfg = loadPrograms(filepath.Join(dirs.config, "programs.toml"))
 }
 
 func onelineErr(er string) string {
@@ -142,16 +197,27 @@ type BindingEntry struct {
 	Sequence    string
 	Actions     []ProgramAction
 	Annotations map[string][]string // e.g. "alone" -> ["f13"]
+
+	// Pos is the source Position the rule form started at, populated by
+	// the parsec-based readers so a future --debug flag can report
+	// file:line for a malformed entry. Zero value for entries built
+	// without a tracked source (e.g. synthesized in tests).
+	Pos parsec.Position
+
+	// Path is the EDN file Pos was measured against, set by parseEDNFile
+	// once parseBindingEntries returns. Empty for entries built without a
+	// tracked source.
+	Path string
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 func completeRenderType(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return []string{"empty", "full", "default"}, cobra.ShellCompDirectiveNoFileComp
+	return []string{"empty", "full", "tree", "default"}, cobra.ShellCompDirectiveNoFileComp
 }
 
 func completePrograms(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return []string{"helix", "helix-common", "helix-insert", "helix-normal", "helix-select", "micro"}, cobra.ShellCompDirectiveNoFileComp
+	return emitters.Names(), cobra.ShellCompDirectiveNoFileComp
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,380 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fsnotify/fsnotify"
+	"github.com/sahilm/fuzzy"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// flashDuration bounds how long an added/removed/modified row stays
+// highlighted after an EDN reload, mirroring watchDebounce's role for the
+// plain `watch` command.
+const flashDuration = 1500 * time.Millisecond
+
+type flashKind int
+
+const (
+	flashNone flashKind = iota
+	flashAdded
+	flashRemoved
+	flashModified
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// runDisplayWatch mounts the Bubble Tea inspector in place of one-shot
+// rendering, tracking --render/--sort the same way the static path does.
+func runDisplayWatch() {
+	entries, err := loadDisplayEntries()
+	if err != nil {
+		log.Fatalf("EDN parsing error: %v", err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Fatalf("failed to start EDN watcher: %v", err)
+	}
+
+	if err := watchEDNDirs(watcher, flags.rootDir); err != nil {
+		log.Fatalf("failed to watch %s: %v", flags.rootDir, err)
+	}
+
+	m := newDisplayModel(entries, watcher)
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		log.Fatalf("display TUI error: %v", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// displayModel is the live-reload inspector: a bubbles table over the
+// current entries, a fuzzy filter, and an fsnotify watcher feeding reload
+// events back in as tea.Msgs.
+type displayModel struct {
+	table   table.Model
+	watcher *fsnotify.Watcher
+
+	entries []BindingEntry
+	rows    []tableRow
+	sortBy  string
+
+	filtering bool
+	filter    string
+
+	flash map[string]flashKind
+}
+
+func newDisplayModel(entries []BindingEntry, watcher *fsnotify.Watcher) displayModel {
+	m := displayModel{
+		table:   newDisplayTable(),
+		watcher: watcher,
+		entries: entries,
+		sortBy:  flags.sortBy,
+		flash:   map[string]flashKind{},
+	}
+	m.refreshRows()
+	return m
+}
+
+func newDisplayTable() table.Model {
+	columns := []table.Column{
+		{Title: "Program", Width: 12},
+		{Title: "Action", Width: 30},
+		{Title: "Trigger", Width: 20},
+		{Title: "Binding", Width: 20},
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithFocused(true),
+		table.WithHeight(20),
+	)
+
+	styles := table.DefaultStyles()
+	styles.Header = styles.Header.Bold(true).BorderBottom(true)
+	styles.Selected = styles.Selected.Bold(true).Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57"))
+	t.SetStyles(styles)
+
+	return t
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// reloadMsg carries a fresh parse of the EDN sources back into Update.
+type reloadMsg struct {
+	entries []BindingEntry
+	err     error
+}
+
+// clearFlashMsg tells Update to drop rows whose flash has expired.
+type clearFlashMsg struct{}
+
+func (m displayModel) Init() tea.Cmd {
+	return waitForEDNChange(m.watcher)
+}
+
+// waitForEDNChange blocks on the watcher until a *.edn file changes, then
+// reports back with a fresh parse — bubbletea's equivalent of cmdWatch's
+// debounced select loop.
+func waitForEDNChange(watcher *fsnotify.Watcher) tea.Cmd {
+	return func() tea.Msg {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return nil
+				}
+				if !strings.HasSuffix(event.Name, ".edn") {
+					continue
+				}
+				time.Sleep(watchDebounce)
+				drainEvents(watcher)
+
+				entries, err := loadDisplayEntries()
+				return reloadMsg{entries: entries, err: err}
+
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return nil
+				}
+				log.Printf("watch error: %v", err)
+			}
+		}
+	}
+}
+
+// drainEvents swallows any events queued during the debounce window so a
+// burst of writes triggers exactly one reload.
+func drainEvents(watcher *fsnotify.Watcher) {
+	for {
+		select {
+		case <-watcher.Events:
+		default:
+			return
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (m displayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.updateKey(msg)
+
+	case reloadMsg:
+		if msg.err != nil {
+			log.Printf("EDN parsing error: %v", msg.err)
+			return m, waitForEDNChange(m.watcher)
+		}
+		m.diffFlash(m.entries, msg.entries)
+		m.entries = msg.entries
+		m.refreshRows()
+		return m, tea.Batch(waitForEDNChange(m.watcher), clearFlashAfter())
+
+	case clearFlashMsg:
+		m.flash = map[string]flashKind{}
+		m.refreshRows()
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.table.SetHeight(msg.Height - 6)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func clearFlashAfter() tea.Cmd {
+	return tea.Tick(flashDuration, func(time.Time) tea.Msg { return clearFlashMsg{} })
+}
+
+func (m displayModel) updateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.filtering {
+		switch msg.String() {
+		case "enter", "esc":
+			m.filtering = false
+		case "backspace":
+			if len(m.filter) > 0 {
+				m.filter = m.filter[:len(m.filter)-1]
+			}
+		default:
+			m.filter += msg.String()
+		}
+		m.refreshRows()
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "/":
+		m.filtering = true
+		return m, nil
+	case "P":
+		m.sortBy = "program"
+		m.refreshRows()
+		return m, nil
+	case "A":
+		m.sortBy = "action"
+		m.refreshRows()
+		return m, nil
+	case "T":
+		m.sortBy = "trigger"
+		m.refreshRows()
+		return m, nil
+	case "B":
+		m.sortBy = "binding"
+		m.refreshRows()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// refreshRows rebuilds the table's rows from m.entries, applying the
+// current fuzzy filter and sort field.
+func (m *displayModel) refreshRows() {
+	prevSort := flags.sortBy
+	flags.sortBy = m.sortBy
+	rows := buildRows(m.entries)
+	flags.sortBy = prevSort
+
+	rows = fuzzyFilterRows(rows, m.filter)
+	m.rows = rows
+
+	tableRows := make([]table.Row, len(rows))
+	for i, r := range rows {
+		label := rowLabel(r)
+		if flash, ok := m.flash[rowKey(r)]; ok {
+			label.Program = flashLabel(flash, label.Program)
+		}
+		tableRows[i] = table.Row{label.Program, label.Action, label.Trigger, label.Binding}
+	}
+	m.table.SetRows(tableRows)
+}
+
+type rowLabelSet struct {
+	Program, Action, Trigger, Binding string
+}
+
+func rowLabel(r tableRow) rowLabelSet {
+	return rowLabelSet{Program: r.Program, Action: r.Action, Trigger: r.Trigger, Binding: r.Binding}
+}
+
+func flashLabel(kind flashKind, program string) string {
+	switch kind {
+	case flashAdded:
+		return "+ " + program
+	case flashRemoved:
+		return "- " + program
+	case flashModified:
+		return "~ " + program
+	default:
+		return program
+	}
+}
+
+// fuzzyFilterRows narrows rows to those whose Program+Action+Binding fuzzy
+// match query, searching BindingEntry.Actions the way `/` search is meant
+// to. An empty query is a no-op.
+func fuzzyFilterRows(rows []tableRow, query string) []tableRow {
+	if query == "" {
+		return rows
+	}
+
+	haystack := make([]string, len(rows))
+	for i, r := range rows {
+		haystack[i] = fmt.Sprintf("%s %s %s", r.Program, r.Action, r.Binding)
+	}
+
+	matches := fuzzy.Find(query, haystack)
+	out := make([]tableRow, len(matches))
+	for i, match := range matches {
+		out[i] = rows[match.Index]
+	}
+	return out
+}
+
+// rowKey identifies a row across reloads independent of sort order.
+func rowKey(r tableRow) string {
+	return fmt.Sprintf("%s|%s|%s", r.Program, r.Action, r.Trigger)
+}
+
+// diffFlash compares before/after entries and flags which rows changed, so
+// the next refreshRows can highlight them.
+func (m *displayModel) diffFlash(before, after []BindingEntry) {
+	beforeRows := buildRows(before)
+	afterRows := buildRows(after)
+
+	beforeByKey := make(map[string]tableRow, len(beforeRows))
+	for _, r := range beforeRows {
+		beforeByKey[rowKey(r)] = r
+	}
+	afterKeys := make(map[string]bool, len(afterRows))
+
+	for _, r := range afterRows {
+		key := rowKey(r)
+		afterKeys[key] = true
+		if old, ok := beforeByKey[key]; !ok {
+			m.flash[key] = flashAdded
+		} else if old != r {
+			m.flash[key] = flashModified
+		}
+	}
+	for key := range beforeByKey {
+		if !afterKeys[key] {
+			m.flash[key] = flashRemoved
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (m displayModel) View() string {
+	var b strings.Builder
+	b.WriteString(m.table.View())
+	b.WriteString("\n")
+
+	if m.filtering {
+		fmt.Fprintf(&b, "/%s\n", m.filter)
+	}
+
+	fmt.Fprintf(&b, "sort: %s  rows: %d  (P/A/T/B sort · / filter · q quit)\n", m.sortBy, len(m.rows))
+	return b.String()
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
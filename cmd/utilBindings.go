@@ -0,0 +1,69 @@
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"github.com/DanielRivasMD/Babel/internal/bindings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// toBindingEntries adapts cmd's BindingEntry tree to the shared
+// internal/bindings model so it can be handed to an encoder.
+func toBindingEntries(entries []BindingEntry) []bindings.Entry {
+	out := make([]bindings.Entry, len(entries))
+	for i, e := range entries {
+		out[i] = bindings.Entry{
+			Trigger:     toBindingTrigger(e.Trigger),
+			Binding:     toBindingTrigger(e.Binding),
+			Sequence:    e.Sequence,
+			Actions:     toBindingActions(e.Actions),
+			Annotations: e.Annotations,
+		}
+	}
+	return out
+}
+
+// fromBindingEntries is toBindingEntries' inverse, used after decoding an
+// external source (e.g. karabiner.json) back into babel's own model.
+func fromBindingEntries(entries []bindings.Entry) []BindingEntry {
+	out := make([]BindingEntry, len(entries))
+	for i, e := range entries {
+		out[i] = BindingEntry{
+			Trigger:     fromBindingTrigger(e.Trigger),
+			Binding:     fromBindingTrigger(e.Binding),
+			Sequence:    e.Sequence,
+			Actions:     fromBindingActions(e.Actions),
+			Annotations: e.Annotations,
+		}
+	}
+	return out
+}
+
+func toBindingTrigger(k KeySeq) bindings.Trigger {
+	return bindings.Trigger{Mode: k.Mode, Modifier: k.Modifier, Key: k.Key}
+}
+
+func fromBindingTrigger(t bindings.Trigger) KeySeq {
+	return KeySeq{Mode: t.Mode, Modifier: t.Modifier, Key: t.Key}
+}
+
+func toBindingActions(actions []ProgramAction) []bindings.Action {
+	out := make([]bindings.Action, len(actions))
+	for i, a := range actions {
+		out[i] = bindings.Action{Program: a.Program, Action: a.Action, Command: a.Command}
+	}
+	return out
+}
+
+func fromBindingActions(actions []bindings.Action) []ProgramAction {
+	out := make([]ProgramAction, len(actions))
+	for i, a := range actions {
+		out[i] = ProgramAction{Program: a.Program, Action: a.Action, Command: a.Command}
+	}
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
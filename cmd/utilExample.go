@@ -0,0 +1,43 @@
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// exampleRoot and its siblings below are referenced by every command's
+// Example field but were never defined, a pre-existing gap that predates
+// this file (confirmed present in the baseline commit). Kept minimal,
+// one realistic invocation per command, in cobra's conventional two-space
+// indented style.
+
+var exampleRoot = `  babel display --root ~/.config/babel --program helix
+  babel key --file ~/.config/babel/bindings.edn`
+
+var exampleDisplay = `  babel display --file bindings.edn --format ascii
+  babel display --root ~/.config/babel --render tree --markdown`
+
+var exampleKey = `  babel key --file bindings.edn
+  babel key --root ~/.config/babel --render full`
+
+var exampleInterpret = `  babel interpret --file bindings.edn --target ~/.config/helix/config.toml`
+
+var exampleEmbed = `  babel embed --target ~/.config/lazygit/config.yml
+  babel embed --target ~/.config/broot/conf.hjson --dry-run`
+
+var exampleWatch = `  babel watch --targets helix-normal=~/.config/helix/config.toml`
+
+var exampleDoc = `  babel doc --input karabiner.edn --output keymap.toml`
+
+var exampleConvert = `  babel convert --from edn --file bindings.edn --to json --output karabiner.json`
+
+var exampleParseJSON = `  babel parsejson --file karabiner.json
+  babel parsejson --file karabiner.json --edn`
+
+var exampleLint = `  babel lint --file bindings.edn --format ascii`
+
+var exampleParseedn = `  babel parseedn --file bindings.edn --keyboard ansi
+  babel parseedn --file bindings.edn --svg heatmap.svg --usage-log keys.csv`
+
+var exampleDiff = `  babel diff --old v1.edn --new v2.edn --format markdown`
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
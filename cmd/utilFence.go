@@ -0,0 +1,163 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DanielRivasMD/horus"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// fenceComment maps a --fence-style value to the line-comment syntax its
+// target config language uses, so one BEGIN/END fence convention works
+// across embed targets that otherwise have nothing in common.
+var fenceComment = map[string]string{
+	"yaml": "#",
+	"toml": "#",
+	"kdl":  "//",
+	"edn":  ";;",
+}
+
+// fenceMarkers returns the BEGIN/END marker lines embed splices its
+// generated block between. An unrecognized or empty style falls back to
+// "#", since that's the comment syntax of the first two targets embed
+// grew (lazygit's yaml, broot's toml).
+func fenceMarkers(style, program string) (begin, end string) {
+	c, ok := fenceComment[style]
+	if !ok {
+		c = "#"
+	}
+	return fmt.Sprintf("%s BEGIN babel:%s", c, program), fmt.Sprintf("%s END babel:%s", c, program)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// spliceFence replaces the lines between begin/end in text with body,
+// leaving everything outside the fence untouched. If the fence isn't
+// present yet, a fresh one is appended at EOF so the first embed run
+// against a hand-written config still lands somewhere deterministic.
+func spliceFence(text, begin, end, body string) string {
+	lines := fenceLines(text)
+
+	startIdx, endIdx := -1, -1
+	for i, l := range lines {
+		if strings.TrimSpace(l) == begin {
+			startIdx = i
+			continue
+		}
+		if startIdx != -1 && strings.TrimSpace(l) == end {
+			endIdx = i
+			break
+		}
+	}
+
+	if startIdx == -1 || endIdx == -1 {
+		var out strings.Builder
+		out.WriteString(text)
+		if text != "" && !strings.HasSuffix(text, "\n") {
+			out.WriteString("\n")
+		}
+		out.WriteString(begin + "\n")
+		if body != "" {
+			out.WriteString(body + "\n")
+		}
+		out.WriteString(end + "\n")
+		return out.String()
+	}
+
+	spliced := append([]string{}, lines[:startIdx+1]...)
+	if body != "" {
+		spliced = append(spliced, strings.Split(body, "\n")...)
+	}
+	spliced = append(spliced, lines[endIdx:]...)
+	return strings.Join(spliced, "\n") + "\n"
+}
+
+// fenceLines splits text into lines without a trailing empty element for
+// its final "\n", mirroring internal/diffing's splitLines.
+func fenceLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// sortedFenceBody joins a key->line map in ascending key order, so the
+// fenced block's diff stays minimal across runs: an entry that didn't
+// change doesn't move just because an unrelated entry was added.
+func sortedFenceBody(lines map[string]string) string {
+	keys := make([]string, 0, len(lines))
+	for k := range lines {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, lines[k])
+	}
+	return strings.Join(out, "\n")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// detectConflicts fails if two BindingEntry values would generate the same
+// binding key for the same program. The pre-fence raw-replace path let
+// this pass silently (last write wins); once generation fills one fenced
+// block per program, a silent collision would just drop a binding.
+func detectConflicts(filtered []BindingEntry, program string) error {
+	type seenAt struct {
+		path string
+		line int
+	}
+	seen := make(map[string]seenAt)
+
+	for _, entry := range filtered {
+		for _, act := range entry.Actions {
+			key := formatKeySeq(entry.Binding, lookups.embed, act.Program, "-")
+			if prev, ok := seen[key]; ok {
+				return horus.NewHerror(
+					"embed.detectConflicts",
+					"duplicate binding key for program",
+					nil,
+					map[string]any{
+						"program": program,
+						"key":     key,
+						"first":   fmt.Sprintf("%s:%d", prev.path, prev.line),
+						"second":  fmt.Sprintf("%s:%d", entry.Path, entry.Pos.Line),
+					},
+				)
+			}
+			seen[key] = seenAt{path: entry.Path, line: entry.Pos.Line}
+		}
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
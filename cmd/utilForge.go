@@ -6,8 +6,12 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"log"
 	"strings"
 
+	"github.com/DanielRivasMD/Babel/internal/diffing"
+	"github.com/DanielRivasMD/Babel/internal/forge"
 	"github.com/DanielRivasMD/domovoi"
 	"github.com/DanielRivasMD/horus"
 )
@@ -15,8 +19,11 @@ import (
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type mbomboReplace struct {
-	old string
-	new string
+	old       string
+	new       string
+	regex     bool
+	wholeWord bool
+	wholeLine bool
 }
 
 type mbomboForge struct {
@@ -39,22 +46,95 @@ func newMbomboConfig(
 	}
 }
 
-func mbomboForging(op string, mf mbomboForge) {
+// mbomboForging forges mf.out from mf.files, applying mf.replaces. It
+// forges in-process via internal/forge by default; --external-mbombo
+// switches back to shelling out to the mbombo binary (Cmd()), kept for
+// backwards compatibility with setups that still depend on it.
+//
+// When noWrite is true (embed --dry-run/--diff), nothing is written to
+// disk: mf.out is instead rendered before/after via forge.Render, the
+// unified diff between them is written to w, and — only under
+// --dry-run — an unchanged result (before == after) fails the run, since
+// that means one of mf.replaces' anchors is stale and silently did
+// nothing, the kind of drift a CI check should catch. --external-mbombo
+// doesn't support noWrite, since that path shells out to the real mbombo
+// binary instead of going through forge.Render.
+func mbomboForging(op string, mf mbomboForge, w io.Writer, noWrite bool) {
+	if flags.embedExternal {
+		horus.CheckErr(
+			domovoi.ExecSh(mf.Cmd()),
+			horus.WithOp(op),
+			horus.WithCategory("shell_command"),
+			horus.WithMessage("Failed to execute mbombo forge command"),
+			horus.WithDetails(map[string]any{
+				"command": mf.Cmd(),
+			}),
+		)
+		return
+	}
+
+	replaces := make([]forge.Replace, len(mf.replaces))
+	for i, r := range mf.replaces {
+		replaces[i] = forge.Replace{Old: r.old, New: r.new, Regex: r.regex, WholeWord: r.wholeWord, WholeLine: r.wholeLine}
+	}
+
+	if !noWrite {
+		horus.CheckErr(
+			forge.Forge(mf.out, mf.files, replaces),
+			horus.WithOp(op),
+			horus.WithCategory("forge_error"),
+			horus.WithMessage("Failed to forge output file"),
+			horus.WithDetails(map[string]any{
+				"out":   mf.out,
+				"files": mf.files,
+			}),
+		)
+		return
+	}
+
+	before, after, err := forge.Render(mf.files, replaces)
 	horus.CheckErr(
-		domovoi.ExecSh(mf.Cmd()),
+		err,
 		horus.WithOp(op),
-		horus.WithCategory("shell_command"),
-		horus.WithMessage("Failed to execute mbombo forge command"),
+		horus.WithCategory("forge_error"),
+		horus.WithMessage("Failed to render forge preview"),
 		horus.WithDetails(map[string]any{
-			"command": mf.Cmd(),
+			"out":   mf.out,
+			"files": mf.files,
 		}),
 	)
+
+	fmt.Fprint(w, diffing.Unified(before, after, "a/"+mf.out, "b/"+mf.out, 3))
+
+	if flags.embedDryRun && before == after {
+		log.Fatalf("embed --dry-run: %s would be unchanged by %s (stale replace anchor?)", mf.out, op)
+	}
 }
 
+// Replace builds a literal (non-regex, any-position) substitution.
 func Replace(key, val string) mbomboReplace {
 	return mbomboReplace{old: key, new: val}
 }
 
+// ReplaceRegex builds a substitution whose Old is a regular expression;
+// New may use Go regexp replacement syntax (e.g. "$1").
+func ReplaceRegex(pattern, tmpl string) mbomboReplace {
+	return mbomboReplace{old: pattern, new: tmpl, regex: true}
+}
+
+// ReplaceWholeWord builds a literal substitution that only matches whole
+// words, so e.g. replacing "go" doesn't also hit "going".
+func ReplaceWholeWord(key, val string) mbomboReplace {
+	return mbomboReplace{old: key, new: val, wholeWord: true}
+}
+
+// ReplaceWholeLine builds a substitution that replaces the entire line
+// containing a literal match of key with val, instead of splicing val in
+// over just the matched substring.
+func ReplaceWholeLine(key, val string) mbomboReplace {
+	return mbomboReplace{old: key, new: val, wholeLine: true}
+}
+
 func (m mbomboForge) Cmd() string {
 	var files []string
 	for _, f := range m.files {
@@ -64,7 +144,11 @@ func (m mbomboForge) Cmd() string {
 
 	var replaces []string
 	for _, r := range m.replaces {
-		replaces = append(replaces, fmt.Sprintf(`--replace %s="%s"`, r.old, r.new))
+		val := r.new
+		if r.wholeLine {
+			val += ":line"
+		}
+		replaces = append(replaces, fmt.Sprintf(`--replace %s="%s"`, r.old, val))
 	}
 	replaceBlock := strings.Join(replaces, " \\\n")
 
@@ -0,0 +1,198 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DanielRivasMD/Babel/layouts"
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const heatmapUnit = 48.0 // px per key-unit cell
+
+// loadUsageLog reads a two-column "key,count" CSV (no header) of keypress
+// counts, e.g. exported from a karabiner-event-logger, keyed by the same
+// label KeyboardConfig.Letters/.SpecialKeys use.
+func loadUsageLog(path string) (map[string]int, error) {
+	usage := make(map[string]int)
+	if path == "" {
+		return usage, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rec) < 2 {
+			continue
+		}
+		count, err := strconv.Atoi(strings.TrimSpace(rec[1]))
+		if err != nil {
+			continue
+		}
+		usage[strings.TrimSpace(rec[0])] = count
+	}
+	return usage, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// keyValue looks up a Cell's label in whichever of KeyboardConfig's two
+// maps it belongs to.
+func keyValue(cfg KeyboardConfig, label string) (value string, ok bool) {
+	if v, found := cfg.Letters[label]; found {
+		return v, true
+	}
+	if v, found := cfg.SpecialKeys[label]; found {
+		return v, true
+	}
+	return "", false
+}
+
+// heatmapColor picks a cell's base fill from its category (letter vs
+// special, bound vs DefaultKey), then blends it toward red as usage grows
+// relative to maxUsage.
+func heatmapColor(isLetter, bound bool, usage, maxUsage int) string {
+	var r, g, b int
+	switch {
+	case !bound:
+		r, g, b = 0xe0, 0xe0, 0xe0 // unbound: neutral gray
+	case isLetter:
+		r, g, b = 0x4a, 0x6f, 0xd6 // bound letter: blue
+	default:
+		r, g, b = 0x4a, 0xb6, 0x6f // bound special: green
+	}
+
+	if maxUsage <= 0 || usage <= 0 {
+		return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	}
+	heat := float64(usage) / float64(maxUsage)
+	if heat > 1 {
+		heat = 1
+	}
+	r = r + int(heat*float64(0xff-r))
+	g = g - int(heat*float64(g))
+	b = b - int(heat*float64(b))
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// renderHeatmapSVG draws one rect + label per layout Cell whose label is
+// tracked by cfg, colored by category and (if usage is non-empty) keypress
+// heat, and returns the standalone SVG document as a string.
+func renderHeatmapSVG(cfg KeyboardConfig, layout layouts.Layout, usage map[string]int) string {
+	maxUsage := 0
+	for _, c := range usage {
+		if c > maxUsage {
+			maxUsage = c
+		}
+	}
+
+	var maxCol, maxRow float64
+	for _, cell := range layout.Cells {
+		if right := cell.Col + cell.Width; right > maxCol {
+			maxCol = right
+		}
+		if bottom := cell.Row + cell.Height; bottom > maxRow {
+			maxRow = bottom
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="monospace" font-size="12">`+"\n",
+		int(maxCol*heatmapUnit), int(maxRow*heatmapUnit))
+
+	for _, cell := range layout.Cells {
+		value, tracked := keyValue(cfg, cell.Label)
+		if !tracked {
+			continue
+		}
+		isLetter := len(cell.Label) == 1
+		bound := value != "" && value != DefaultKey
+		h := cell.Height
+		if h == 0 {
+			h = 1
+		}
+		fill := heatmapColor(isLetter, bound, usage[cell.Label], maxUsage)
+
+		x, y := cell.Col*heatmapUnit, cell.Row*heatmapUnit
+		w, hh := cell.Width*heatmapUnit-2, h*heatmapUnit-2
+
+		fmt.Fprintf(&buf, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" rx="4" fill="%s" stroke="#333" stroke-width="1"/>`+"\n",
+			x, y, w, hh, fill)
+		fmt.Fprintf(&buf, `<text x="%.1f" y="%.1f" text-anchor="middle">%s</text>`+"\n",
+			x+w/2, y+hh/2+4, cell.Label)
+	}
+
+	buf.WriteString("</svg>\n")
+	return buf.String()
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// renderHeatmapPNG rasterizes an SVG document (as produced by
+// renderHeatmapSVG) into PNG bytes, so the heatmap drops cleanly into a
+// README that doesn't render inline SVG.
+func renderHeatmapPNG(svg string) ([]byte, error) {
+	icon, err := oksvg.ReadIconStream(strings.NewReader(svg), oksvg.WarnErrorMode)
+	if err != nil {
+		return nil, fmt.Errorf("parsing rendered SVG: %w", err)
+	}
+
+	w, h := int(icon.ViewBox.W), int(icon.ViewBox.H)
+	icon.SetTarget(0, 0, float64(w), float64(h))
+
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	scanner := rasterx.NewScannerGV(w, h, img, img.Bounds())
+	raster := rasterx.NewDasher(w, h, scanner)
+	icon.Draw(raster, 1.0)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encoding PNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
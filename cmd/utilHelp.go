@@ -22,6 +22,12 @@ var helpDisplay = domovoi.FormatHelp(
 	"Scan EDN metadata & output Markdown table",
 )
 
+var helpKey = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"danielrivasmd@gmail.com",
+	"Scan EDN metadata & output a plain ASCII table",
+)
+
 var helpInterpret = domovoi.FormatHelp(
 	"Daniel Rivas",
 	"<danielrivasmd@gmail.com>",
@@ -40,4 +46,52 @@ var helpEmbed = domovoi.FormatHelp(
 	"Inserting key sequences over templates",
 )
 
+var helpWatch = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"<danielrivasmd@gmail.com>",
+	"Regenerate configs as EDN sources change",
+)
+
+var helpDoc = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"<danielrivasmd@gmail.com>",
+	"Normalise a karabiner.edn into a canonical keymap table",
+)
+
+var helpConvert = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"<danielrivasmd@gmail.com>",
+	"Convert bindings between EDN and Karabiner JSON",
+)
+
+var helpParseJSON = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"<danielrivasmd@gmail.com>",
+	"Read a karabiner.json and display or convert its bindings",
+)
+
+var helpLint = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"<danielrivasmd@gmail.com>",
+	"Report duplicate, shadowed, and default-key bindings",
+)
+
+var helpParseEdn = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"<danielrivasmd@gmail.com>",
+	"Render the active TC layer as an ASCII diagram or SVG/PNG heatmap",
+)
+
+var helpLSP = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"<danielrivasmd@gmail.com>",
+	"Speak LSP over stdio: symbols, definitions, and hovers for .edn binding files",
+)
+
+var helpDiff = domovoi.FormatHelp(
+	"Daniel Rivas",
+	"<danielrivasmd@gmail.com>",
+	"Diff bindings between two EDN revisions",
+)
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
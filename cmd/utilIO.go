@@ -89,6 +89,16 @@ func loadFormat(path string) map[string]map[string]string {
 	return cfg
 }
 
+// loadPrograms reads the program/family descriptors used by emitConfig and
+// formatBinds to decide header lines, output syntax and family expansion.
+func loadPrograms(path string) programsConfig {
+	var cfg programsConfig
+	if _, err := toml.DecodeFile(path, &cfg); err != nil {
+		log.Fatalf("failed to load program config from %s: %v", path, err)
+	}
+	return cfg
+}
+
 type KeyLookup func(string) string
 
 func buildLookupFuncs(cfg map[string]map[string]string) map[string]KeyLookup {
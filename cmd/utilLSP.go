@@ -0,0 +1,313 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/DanielRivasMD/Babel/internal/parsec"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lspDiagnostic is a parse failure surfaced via textDocument/publishDiagnostics,
+// anchored at the source Position decodeMetadata/decodeRule failed on.
+type lspDiagnostic struct {
+	Pos     parsec.Position
+	Message string
+}
+
+// lspServer holds the open-document buffers a session is tracking, keyed
+// by URI, so didChange/documentSymbol/definition/hover all see the same
+// in-memory text instead of re-reading from disk on every request.
+type lspServer struct {
+	docs map[string]string
+}
+
+func newLSPServer() *lspServer {
+	return &lspServer{docs: make(map[string]string)}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// serveLSP runs the read-dispatch-write loop until stdin closes.
+func serveLSP(r *bufio.Reader, w io.Writer) {
+	srv := newLSPServer()
+	for {
+		body, err := readLSPMessage(r)
+		if err != nil {
+			return
+		}
+
+		var req jsonrpcRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			continue
+		}
+
+		srv.handle(req, w)
+	}
+}
+
+func (s *lspServer) handle(req jsonrpcRequest, w io.Writer) {
+	switch req.Method {
+	case "initialize":
+		s.reply(w, req.ID, map[string]any{
+			"capabilities": map[string]any{
+				"textDocumentSync":       1, // full-document sync
+				"documentSymbolProvider": true,
+				"definitionProvider":     true,
+				"hoverProvider":          true,
+			},
+		})
+
+	case "initialized", "exit", "$/cancelRequest":
+		// notifications; no response expected
+
+	case "shutdown":
+		s.reply(w, req.ID, nil)
+
+	case "textDocument/didOpen":
+		var p struct {
+			TextDocument struct {
+				URI  string `json:"uri"`
+				Text string `json:"text"`
+			} `json:"textDocument"`
+		}
+		if json.Unmarshal(req.Params, &p) == nil {
+			s.docs[p.TextDocument.URI] = p.TextDocument.Text
+			s.publishDiagnostics(w, p.TextDocument.URI)
+		}
+
+	case "textDocument/didChange":
+		var p struct {
+			TextDocument struct {
+				URI string `json:"uri"`
+			} `json:"textDocument"`
+			ContentChanges []struct {
+				Text string `json:"text"`
+			} `json:"contentChanges"`
+		}
+		if json.Unmarshal(req.Params, &p) == nil && len(p.ContentChanges) > 0 {
+			s.docs[p.TextDocument.URI] = p.ContentChanges[len(p.ContentChanges)-1].Text
+			s.publishDiagnostics(w, p.TextDocument.URI)
+		}
+
+	case "textDocument/documentSymbol":
+		var p struct {
+			TextDocument struct {
+				URI string `json:"uri"`
+			} `json:"textDocument"`
+		}
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			s.reply(w, req.ID, []any{})
+			return
+		}
+		s.reply(w, req.ID, s.documentSymbols(p.TextDocument.URI))
+
+	case "textDocument/definition":
+		var p lspPositionParams
+		if json.Unmarshal(req.Params, &p) == nil {
+			if loc := s.definition(p); loc != nil {
+				s.reply(w, req.ID, loc)
+				return
+			}
+		}
+		s.reply(w, req.ID, nil)
+
+	case "textDocument/hover":
+		var p lspPositionParams
+		if json.Unmarshal(req.Params, &p) == nil {
+			if hover := s.hover(p); hover != nil {
+				s.reply(w, req.ID, hover)
+				return
+			}
+		}
+		s.reply(w, req.ID, nil)
+
+	default:
+		if len(req.ID) > 0 {
+			s.replyError(w, req.ID, -32601, fmt.Sprintf("method not found: %s", req.Method))
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lspPositionParams covers the params shape textDocument/definition and
+// textDocument/hover share: a document URI plus a 0-indexed line/character.
+type lspPositionParams struct {
+	TextDocument struct {
+		URI string `json:"uri"`
+	} `json:"textDocument"`
+	Position struct {
+		Line      int `json:"line"`
+		Character int `json:"character"`
+	} `json:"position"`
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (s *lspServer) reply(w io.Writer, id json.RawMessage, result any) {
+	writeLSPMessage(w, jsonrpcResponse{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+func (s *lspServer) replyError(w io.Writer, id json.RawMessage, code int, msg string) {
+	writeLSPMessage(w, jsonrpcResponse{JSONRPC: "2.0", ID: id, Error: &jsonrpcError{Code: code, Message: msg}})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// entriesFor parses a tracked document's current buffer the same way
+// parseEDNFile parses one from disk, but through parseBindingEntriesSafe so
+// a malformed rule becomes a diagnostic instead of killing the server.
+func (s *lspServer) entriesFor(uri string) ([]BindingEntry, []lspDiagnostic) {
+	text, ok := s.docs[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	mode := extractMode(text)
+	entries, diags := parseBindingEntriesSafe(text, mode)
+	for i := range entries {
+		entries[i].Path = uri
+	}
+	return entries, diags
+}
+
+func (s *lspServer) publishDiagnostics(w io.Writer, uri string) {
+	_, diags := s.entriesFor(uri)
+
+	lspDiags := make([]map[string]any, len(diags))
+	for i, d := range diags {
+		lspDiags[i] = map[string]any{
+			"range":    lspRangeAt(d.Pos),
+			"severity": 1, // error
+			"message":  d.Message,
+		}
+	}
+
+	writeLSPMessage(w, jsonrpcNotification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params: map[string]any{
+			"uri":         uri,
+			"diagnostics": lspDiags,
+		},
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// documentSymbols returns one SymbolInformation per ^{…}[…] block.
+func (s *lspServer) documentSymbols(uri string) []map[string]any {
+	entries, _ := s.entriesFor(uri)
+
+	symbols := make([]map[string]any, 0, len(entries))
+	for _, e := range entries {
+		symbols = append(symbols, map[string]any{
+			"name": symbolName(e),
+			"kind": 12, // Function
+			"location": map[string]any{
+				"uri":   uri,
+				"range": lspRangeAt(e.Pos),
+			},
+		})
+	}
+	return symbols
+}
+
+// symbolName names a BindingEntry's symbol after its first action. Babel's
+// :doc/actions entries carry Program/Action/Command rather than a bare
+// "name" field, so Action is the closest live analog; entries with no
+// actions fall back to their trigger's token sequence.
+func symbolName(e BindingEntry) string {
+	if len(e.Actions) > 0 && e.Actions[0].Action != "" {
+		return e.Actions[0].Action
+	}
+	return strings.Join(tokenizeSequence(e), " ")
+}
+
+// nearestEntry returns the entry whose block starts at or before line
+// (1-indexed), preferring the latest such start — i.e. the block the
+// position falls inside of, given entries don't carry an explicit end line.
+func nearestEntry(entries []BindingEntry, line int) *BindingEntry {
+	var best *BindingEntry
+	for i := range entries {
+		e := &entries[i]
+		if e.Pos.Line > line {
+			continue
+		}
+		if best == nil || e.Pos.Line > best.Pos.Line {
+			best = e
+		}
+	}
+	return best
+}
+
+// definition resolves the position to its enclosing ^{…}[…] block and
+// returns that block's own source range: the jump target for a Binding
+// string is the rule that defines it.
+func (s *lspServer) definition(p lspPositionParams) map[string]any {
+	entries, _ := s.entriesFor(p.TextDocument.URI)
+	best := nearestEntry(entries, p.Position.Line+1)
+	if best == nil {
+		return nil
+	}
+	return map[string]any{
+		"uri":   p.TextDocument.URI,
+		"range": lspRangeAt(best.Pos),
+	}
+}
+
+// hover renders the enclosing entry as the same Markdown table shape the
+// table renderers already produce (buildRows), so a hover tooltip and
+// `babel display` never disagree about a binding's columns.
+func (s *lspServer) hover(p lspPositionParams) map[string]any {
+	entries, _ := s.entriesFor(p.TextDocument.URI)
+	best := nearestEntry(entries, p.Position.Line+1)
+	if best == nil {
+		return nil
+	}
+
+	rows := buildRows([]BindingEntry{*best})
+
+	var b strings.Builder
+	b.WriteString("| Program | Action | Trigger | Binding |\n")
+	b.WriteString("|---|---|---|---|\n")
+	for _, r := range rows {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", r.Program, r.Action, r.Trigger, r.Binding)
+	}
+
+	return map[string]any{
+		"contents": map[string]any{
+			"kind":  "markdown",
+			"value": b.String(),
+		},
+	}
+}
+
+// lspRangeAt converts a 1-indexed parsec.Position into a zero-width,
+// 0-indexed LSP Range, since extractEntry only tracks where a block starts.
+func lspRangeAt(pos parsec.Position) map[string]any {
+	point := map[string]any{"line": pos.Line - 1, "character": pos.Col - 1}
+	return map[string]any{"start": point, "end": point}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,313 @@
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lintSeverity ranks a lintIssue; lint exits non-zero whenever any issue
+// reaches at least lintWarning.
+type lintSeverity string
+
+const (
+	lintWarning lintSeverity = "warning"
+	lintError   lintSeverity = "error"
+)
+
+// lintIssue is one finding from lintEntries: a program/trigger pair and why
+// it's worth flagging.
+type lintIssue struct {
+	Severity lintSeverity
+	Rule     string
+	Program  string
+	Trigger  string
+	Message  string
+
+	// Path/Line locate the source rule form the issue was raised against,
+	// carried through from BindingEntry.Path/Pos so --format sarif can emit
+	// a real physicalLocation. Zero value when the offending entry has no
+	// tracked source.
+	Path string
+	Line int
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lintEntries walks entries and reports: exact duplicate triggers within a
+// program, triggers shadowed by a shorter bound prefix in the same
+// program's chord tree, and DefaultKey/empty bindings still occupying a
+// trigger slot.
+func lintEntries(entries []BindingEntry) []lintIssue {
+	byTrigger := make(map[string][]BindingEntry)
+	for _, e := range entries {
+		trigger := strings.Join(tokenizeSequence(e), " ")
+		byTrigger[trigger] = append(byTrigger[trigger], e)
+	}
+
+	var issues []lintIssue
+	issues = append(issues, lintDuplicateTriggers(byTrigger)...)
+	issues = append(issues, lintUnreachableSuffixes(entries)...)
+	issues = append(issues, lintDefaultBindings(entries)...)
+	issues = append(issues, lintDuplicateActions(entries)...)
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Trigger != issues[j].Trigger {
+			return issues[i].Trigger < issues[j].Trigger
+		}
+		return issues[i].Program < issues[j].Program
+	})
+	return issues
+}
+
+// lintDuplicateTriggers reports a program binding the exact same trigger
+// more than once.
+func lintDuplicateTriggers(byTrigger map[string][]BindingEntry) []lintIssue {
+	type occurrence struct {
+		path string
+		line int
+	}
+
+	var issues []lintIssue
+	for trigger, group := range byTrigger {
+		if len(group) < 2 {
+			continue
+		}
+
+		counts := make(map[string]int)
+		first := make(map[string]occurrence)
+		for _, e := range group {
+			for _, a := range e.Actions {
+				counts[a.Program]++
+				if _, ok := first[a.Program]; !ok {
+					first[a.Program] = occurrence{path: e.Path, line: e.Pos.Line}
+				}
+			}
+		}
+
+		for program, count := range counts {
+			if count > 1 {
+				loc := first[program]
+				issues = append(issues, lintIssue{
+					Severity: lintError,
+					Rule:     "duplicate-trigger",
+					Program:  program,
+					Trigger:  trigger,
+					Message:  fmt.Sprintf("trigger %q is bound %d times in program %q", trigger, count, program),
+					Path:     loc.path,
+					Line:     loc.line,
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// lintUnreachableSuffixes reports a multi-chord trigger that can never
+// fire because a shorter, already-bound trigger in the same program is a
+// strict prefix of it.
+func lintUnreachableSuffixes(entries []BindingEntry) []lintIssue {
+	type chordBinding struct {
+		tokens  []string
+		program string
+		path    string
+		line    int
+	}
+
+	var bindings []chordBinding
+	for _, e := range entries {
+		if isEmptyEntry(e) {
+			continue
+		}
+		toks := tokenizeSequence(e)
+		for _, a := range e.Actions {
+			bindings = append(bindings, chordBinding{tokens: toks, program: a.Program, path: e.Path, line: e.Pos.Line})
+		}
+	}
+
+	var issues []lintIssue
+	for _, shadow := range bindings {
+		for _, shorter := range bindings {
+			if shorter.program != shadow.program {
+				continue
+			}
+			if len(shorter.tokens) >= len(shadow.tokens) {
+				continue
+			}
+			if isTokenPrefix(shorter.tokens, shadow.tokens) {
+				issues = append(issues, lintIssue{
+					Severity: lintWarning,
+					Rule:     "unreachable-suffix",
+					Program:  shadow.program,
+					Trigger:  strings.Join(shadow.tokens, " "),
+					Message: fmt.Sprintf("trigger %q is unreachable in %q: %q is already bound earlier in the sequence",
+						strings.Join(shadow.tokens, " "), shadow.program, strings.Join(shorter.tokens, " ")),
+					Path: shadow.path,
+					Line: shadow.line,
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// isTokenPrefix reports whether prefix is a strict prefix of full.
+func isTokenPrefix(prefix, full []string) bool {
+	if len(prefix) >= len(full) {
+		return false
+	}
+	for i, tok := range prefix {
+		if full[i] != tok {
+			return false
+		}
+	}
+	return true
+}
+
+// lintDefaultBindings reports triggers that occupy a slot but resolve to
+// DefaultKey or carry no actions at all.
+func lintDefaultBindings(entries []BindingEntry) []lintIssue {
+	var issues []lintIssue
+	for _, e := range entries {
+		if e.Binding.Key != "" && e.Binding.Key != DefaultKey {
+			continue
+		}
+		trigger := strings.Join(tokenizeSequence(e), " ")
+
+		if len(e.Actions) == 0 {
+			issues = append(issues, lintIssue{
+				Severity: lintWarning,
+				Rule:     "default-binding",
+				Trigger:  trigger,
+				Message:  fmt.Sprintf("trigger %q occupies a slot but has no actions", trigger),
+				Path:     e.Path,
+				Line:     e.Pos.Line,
+			})
+			continue
+		}
+		for _, a := range e.Actions {
+			issues = append(issues, lintIssue{
+				Severity: lintWarning,
+				Rule:     "default-binding",
+				Program:  a.Program,
+				Trigger:  trigger,
+				Message:  fmt.Sprintf("trigger %q in program %q occupies a slot but binds DefaultKey", trigger, a.Program),
+				Path:     e.Path,
+				Line:     e.Pos.Line,
+			})
+		}
+	}
+	return issues
+}
+
+// lintDuplicateActions reports an Action name reachable via more than one
+// distinct trigger within the same program: usually a copy-paste leftover
+// rather than an intentional alias.
+func lintDuplicateActions(entries []BindingEntry) []lintIssue {
+	type actionKey struct {
+		program string
+		action  string
+	}
+	type occurrence struct {
+		trigger string
+		path    string
+		line    int
+	}
+
+	byAction := make(map[actionKey][]occurrence)
+	for _, e := range entries {
+		if isEmptyEntry(e) {
+			continue
+		}
+		trigger := strings.Join(tokenizeSequence(e), " ")
+		for _, a := range e.Actions {
+			if a.Action == "" {
+				continue
+			}
+			k := actionKey{program: a.Program, action: a.Action}
+			byAction[k] = append(byAction[k], occurrence{trigger: trigger, path: e.Path, line: e.Pos.Line})
+		}
+	}
+
+	var issues []lintIssue
+	for k, occs := range byAction {
+		triggers := make(map[string]bool)
+		for _, o := range occs {
+			triggers[o.trigger] = true
+		}
+		if len(triggers) < 2 {
+			continue
+		}
+
+		distinct := make([]string, 0, len(triggers))
+		for t := range triggers {
+			distinct = append(distinct, t)
+		}
+		sort.Strings(distinct)
+
+		issues = append(issues, lintIssue{
+			Severity: lintWarning,
+			Rule:     "duplicate-action",
+			Program:  k.program,
+			Trigger:  occs[0].trigger,
+			Message: fmt.Sprintf("action %q in program %q is reachable via %d different triggers: %s",
+				k.action, k.program, len(distinct), strings.Join(distinct, ", ")),
+			Path: occs[0].path,
+			Line: occs[0].line,
+		})
+	}
+	return issues
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lintRows flattens lint findings into the same tableRow shape displayCmd
+// renders, so lint output can go through the existing renderer backends
+// instead of a bespoke format.
+func lintRows(issues []lintIssue) []tableRow {
+	rows := make([]tableRow, len(issues))
+	for i, iss := range issues {
+		msg := iss.Message
+		if iss.Path != "" {
+			msg = fmt.Sprintf("%s (%s:%d)", msg, iss.Path, iss.Line)
+		}
+		rows[i] = tableRow{
+			Program:    iss.Program,
+			Action:     string(iss.Severity),
+			Trigger:    iss.Trigger,
+			Binding:    msg,
+			sourcePath: iss.Path,
+			sourceLine: iss.Line,
+		}
+	}
+	return rows
+}
+
+// renderLintIssues dispatches to the row-level half of whichever --format
+// renderer was requested. "sarif" bypasses the tableRow renderers entirely
+// since SARIF results need structured rule/location fields a flattened row
+// can't carry; "text" is a plain alias for "ascii" matching the vocabulary
+// static-analysis tooling (golangci-lint, staticcheck) already uses.
+func renderLintIssues(format string, issues []lintIssue, w io.Writer) error {
+	switch strings.ToLower(format) {
+	case "sarif":
+		return renderSarifIssues(issues, w)
+	case "text":
+		format = "ascii"
+	}
+
+	render, ok := lookupRowRenderer(format)
+	if !ok {
+		return fmt.Errorf("unsupported --format %q (want %s, sarif, text)", format, strings.Join(rendererNames(), ", "))
+	}
+	return render(lintRows(issues), w)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -7,18 +7,17 @@ package cmd
 import (
 	"fmt"
 	"log"
-	"os"
 	"regexp"
 	"strings"
 	"unicode"
 
-	"github.com/ttacon/chalk"
+	"github.com/DanielRivasMD/Babel/internal/parsec"
 	"olympos.io/encoding/edn"
 )
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func parseBindingEntry(rawMeta map[edn.Keyword]any, vec []any, mode string) *BindingEntry {
+func parseBindingEntry(rawMeta map[edn.Keyword]any, vec []any, mode string, pos parsec.Position) *BindingEntry {
 	if len(vec) < 2 {
 		return nil // malformed rule vector
 	}
@@ -62,19 +61,22 @@ func parseBindingEntry(rawMeta map[edn.Keyword]any, vec []any, mode string) *Bin
 		Binding:  binding,
 		Sequence: seq,
 		Actions:  actions,
+		Pos:      pos,
 	}
 }
 
 func parseBindingEntries(text, mode string) []BindingEntry {
 	var entries []BindingEntry
 	pos := 0
+	cursor := parsec.NewInput(text)
 
 	for {
-		metaStr, vecStr, nextPos, ok := extractEntry(text, pos)
+		metaStr, vecStr, entryStart, nextPos, ok := extractEntry(text, pos)
 		if !ok {
 			break
 		}
 		pos = nextPos
+		cursor = cursor.Advance(entryStart - cursor.Offset)
 
 		rawMeta, err := decodeMetadata(metaStr)
 		if err != nil {
@@ -86,7 +88,8 @@ func parseBindingEntries(text, mode string) []BindingEntry {
 			log.Fatalf("EDN rule decode error: %v", err)
 		}
 
-		if entry := parseBindingEntry(rawMeta, vec, mode); entry != nil {
+		if entry := parseBindingEntry(rawMeta, vec, mode, cursor.Pos()); entry != nil {
+			attachTrailingComment(entry, text, nextPos)
 			entries = append(entries, *entry)
 		}
 	}
@@ -94,10 +97,52 @@ func parseBindingEntries(text, mode string) []BindingEntry {
 	return entries
 }
 
+// parseBindingEntriesSafe is parseBindingEntries without the log.Fatalf: a
+// malformed ^{…}[…] block becomes an lspDiagnostic and scanning continues,
+// since lspServer is a long-running process that can't die on a bad edit.
+func parseBindingEntriesSafe(text, mode string) ([]BindingEntry, []lspDiagnostic) {
+	var entries []BindingEntry
+	var diags []lspDiagnostic
+	pos := 0
+	cursor := parsec.NewInput(text)
+
+	for {
+		metaStr, vecStr, entryStart, nextPos, ok := extractEntry(text, pos)
+		if !ok {
+			break
+		}
+		pos = nextPos
+		cursor = cursor.Advance(entryStart - cursor.Offset)
+
+		rawMeta, err := decodeMetadata(metaStr)
+		if err != nil {
+			diags = append(diags, lspDiagnostic{Pos: cursor.Pos(), Message: fmt.Sprintf("decoding metadata: %v", err)})
+			continue
+		}
+
+		vec, err := decodeRule(vecStr)
+		if err != nil {
+			diags = append(diags, lspDiagnostic{Pos: cursor.Pos(), Message: fmt.Sprintf("decoding rule: %v", err)})
+			continue
+		}
+
+		if entry := parseBindingEntry(rawMeta, vec, mode, cursor.Pos()); entry != nil {
+			attachTrailingComment(entry, text, nextPos)
+			entries = append(entries, *entry)
+		}
+	}
+
+	return entries, diags
+}
+
 func parseEDNFile(path string) ([]BindingEntry, error) {
 	text := loadEDNFile(path)
 	mode := extractMode(text)
-	return parseBindingEntries(text, mode), nil
+	entries := parseBindingEntries(text, mode)
+	for i := range entries {
+		entries[i].Path = path
+	}
+	return entries, nil
 }
 
 func parseEDNFiles(paths []string) ([]BindingEntry, error) {
@@ -114,6 +159,79 @@ func parseEDNFiles(paths []string) ([]BindingEntry, error) {
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// SourceSpan is the byte range one ^{…}[…] block occupied, resolved to
+// 1-indexed line/column positions so a caller can report a diagnostic as
+// "file.edn:12:4: message" instead of a bare byte offset.
+type SourceSpan struct {
+	Start parsec.Position
+	End   parsec.Position
+}
+
+// Parser walks an EDN source's ^{…}[…] blocks the same way
+// parseBindingEntriesSafe does, but returns a SourceSpan per entry (not just
+// its start Position) and reports per-block failures as file:line:col errors
+// rather than lspDiagnostics, for callers outside the LSP server. It reuses
+// extractEntry/extractMode as its scanner rather than introducing a second
+// one — see scanBalanced's doc comment for why those no longer mis-scan
+// strings, char literals, and comments.
+type Parser struct {
+	text string
+	mode string
+}
+
+// NewParser builds a Parser over an EDN source's full text and its
+// already-resolved :rules mode (see extractMode).
+func NewParser(text, mode string) *Parser {
+	return &Parser{text: text, mode: mode}
+}
+
+// Parse returns one BindingEntry and matching SourceSpan per well-formed
+// block, in source order, plus one error per block extractEntry found but
+// couldn't decode — it does not stop at the first failure.
+func (p *Parser) Parse() ([]BindingEntry, []SourceSpan, []error) {
+	var entries []BindingEntry
+	var spans []SourceSpan
+	var errs []error
+
+	pos := 0
+	cursor := parsec.NewInput(p.text)
+	for {
+		metaStr, vecStr, entryStart, nextPos, ok := extractEntry(p.text, pos)
+		if !ok {
+			break
+		}
+		pos = nextPos
+		cursor = cursor.Advance(entryStart - cursor.Offset)
+		start := cursor.Pos()
+		endCursor := cursor.Advance(nextPos - cursor.Offset)
+		end := endCursor.Pos()
+		cursor = endCursor
+
+		rawMeta, err := decodeMetadata(metaStr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%d:%d: decoding metadata: %w", start.Line, start.Col, err))
+			continue
+		}
+		vec, err := decodeRule(vecStr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%d:%d: decoding rule: %w", start.Line, start.Col, err))
+			continue
+		}
+
+		entry := parseBindingEntry(rawMeta, vec, p.mode, start)
+		if entry == nil {
+			errs = append(errs, fmt.Errorf("%d:%d: malformed rule", start.Line, start.Col))
+			continue
+		}
+		entries = append(entries, *entry)
+		spans = append(spans, SourceSpan{Start: start, End: end})
+	}
+
+	return entries, spans, errs
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // stripEDNPrefix trims whitespace and any leading EDN prefix ":!"
 func stripEDNPrefix(str string) string {
 	str = strings.TrimSpace(str)
@@ -135,12 +253,136 @@ func splitEDNKey(str string) (string, string) {
 	return "", str
 }
 
-// extractEntry finds the next ^{…}[…] pair, returns meta & vector & new position
-func extractEntry(text string, startPos int) (metaStr, vecStr string, nextPos int, ok bool) {
+// scanBalanced scans one EDN form starting at text[start] == open (either
+// '{' or '['), skipping over string literals, character literals (`\x`,
+// `\newline`, ...), and `;` line comments so a stray close-like byte inside
+// one of those can't desynchronize the depth count — unlike a plain
+// brace/bracket counter, which silently miscounts on e.g. a command string
+// containing "{" or a `;` comment with a stray "]". Returns the offset just
+// past the matching close, or ok=false if text ends before the form closes.
+func scanBalanced(text string, start int, open, close byte) (end int, ok bool) {
+	depth := 0
+	for i := start; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == ';':
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			i++
+			for i < len(text) && text[i] != '"' {
+				if text[i] == '\\' {
+					i++
+				}
+				i++
+			}
+		case c == '\\' && i+1 < len(text):
+			i++ // character literal: the next rune can't open/close a form
+
+		case c == open:
+			depth++
+		case c == close:
+			depth--
+			if depth == 0 {
+				return i + 1, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// attachTrailingComment looks for a true end-of-line comment right after
+// entry's closing `]` (at textPos in text) and, if found, appends it to
+// entry.Annotations["comment"] — the same map[string][]string Entry already
+// carries for "alone"/"afterup" (see internal/bindings), rather than
+// introducing a one-off struct for this single annotation kind.
+func attachTrailingComment(entry *BindingEntry, text string, textPos int) {
+	comment, ok := scanTrailingComment(text, textPos)
+	if !ok {
+		return
+	}
+	if entry.Annotations == nil {
+		entry.Annotations = map[string][]string{}
+	}
+	entry.Annotations["comment"] = append(entry.Annotations["comment"], comment)
+}
+
+// scanTrailingComment looks for a `;` line comment between from and the
+// next newline, the way a human reading the EDN would: a `;` inside a
+// "..." string or a \x character literal isn't a comment, and a #_<form>
+// discard is skipped whole (vector/map/list form, or else the next
+// whitespace-delimited token) rather than having its contents scanned for
+// a stray `;`. Returns the comment text with its leading `;`s and
+// surrounding space trimmed, and whether a non-empty one was found.
+func scanTrailingComment(text string, from int) (string, bool) {
+	i := from
+	for i < len(text) && text[i] != '\n' {
+		switch c := text[i]; {
+		case c == '"':
+			i++
+			for i < len(text) && text[i] != '"' {
+				if text[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+
+		case c == '\\' && i+1 < len(text):
+			i += 2
+
+		case c == '#' && i+1 < len(text) && text[i+1] == '_':
+			i += 2
+			for i < len(text) && unicode.IsSpace(rune(text[i])) {
+				i++
+			}
+			if i >= len(text) {
+				break
+			}
+			var end int
+			var ok bool
+			switch text[i] {
+			case '[':
+				end, ok = scanBalanced(text, i, '[', ']')
+			case '{':
+				end, ok = scanBalanced(text, i, '{', '}')
+			case '(':
+				end, ok = scanBalanced(text, i, '(', ')')
+			default:
+				end = i
+				for end < len(text) && !unicode.IsSpace(rune(text[end])) && text[end] != '\n' {
+					end++
+				}
+				ok = true
+			}
+			if ok {
+				i = end
+			} else {
+				i++
+			}
+
+		case c == ';':
+			end := i
+			for end < len(text) && text[end] != '\n' {
+				end++
+			}
+			comment := strings.TrimSpace(strings.TrimLeft(text[i:end], ";"))
+			return comment, comment != ""
+
+		default:
+			i++
+		}
+	}
+	return "", false
+}
+
+// extractEntry finds the next ^{…}[…] pair, returns meta & vector, the byte
+// offset the entry started at (the '^'), and the new scan position.
+func extractEntry(text string, startPos int) (metaStr, vecStr string, entryStart, nextPos int, ok bool) {
 	// find next caret
 	delta := strings.IndexRune(text[startPos:], '^')
 	if delta < 0 {
-		return "", "", 0, false
+		return "", "", 0, 0, false
 	}
 	i := startPos + delta
 
@@ -153,26 +395,10 @@ func extractEntry(text string, startPos int) (metaStr, vecStr string, nextPos in
 		return extractEntry(text, i+1)
 	}
 
-	// extract metadata map literal
-	braceCount := 0
-	k := j
-metaLoop:
-	for ; k < len(text); k++ {
-		switch text[k] {
-		case '{':
-			braceCount++
-		case '}':
-			braceCount--
-			if braceCount == 0 {
-				k++ // include closing
-				break metaLoop
-			}
-		}
-	}
-	if braceCount != 0 {
-		return "", "", 0, false
+	metaEnd, ok := scanBalanced(text, j, '{', '}')
+	if !ok {
+		return "", "", 0, 0, false
 	}
-	metaEnd := k
 	metaStr = text[j:metaEnd]
 
 	// skip to '['
@@ -184,59 +410,49 @@ metaLoop:
 		return extractEntry(text, metaEnd)
 	}
 
-	// extract the vector literal
-	bracketCount := 0
-	q := p
-vecLoop:
-	for ; q < len(text); q++ {
-		switch text[q] {
-		case '[':
-			bracketCount++
-		case ']':
-			bracketCount--
-			if bracketCount == 0 {
-				q++ // include closing
-				break vecLoop
-			}
-		}
-	}
-	if bracketCount != 0 {
-		return "", "", 0, false
+	vecEnd, ok := scanBalanced(text, p, '[', ']')
+	if !ok {
+		return "", "", 0, 0, false
 	}
-	vecEnd := q
 	vecStr = text[p:vecEnd]
-	return metaStr, vecStr, vecEnd, true
+	return metaStr, vecStr, i, vecEnd, true
 }
 
-// extractMode finds the first symbol immediately under :rules,
-// e.g. [:q-mode …], trims the leading ':', splits on '-'
-// and returns the first character as a lowercase string
+// extractMode finds the first symbol immediately under :rules, e.g.
+// [:q-mode …], trims the leading ':', splits on '-' and returns the first
+// segment as the mode. Unlike a fixed-width lookahead window, this walks
+// token by token so it isn't capped at some guessed byte count.
 func extractMode(text string) string {
-	ixSpace := 20 // TODO: random hardcode number
-	// locate the ":rules" clause
 	ruleStart := strings.Index(text, ":rules")
 	if ruleStart < 0 {
 		return ""
 	}
+
 	// find the '[' that starts the rules vector
-	sliceRule := text[ruleStart : ruleStart+ixSpace]
-	brOpen := strings.Index(sliceRule, "[")
+	brOpen := strings.IndexRune(text[ruleStart:], '[')
 	if brOpen < 0 {
 		return ""
 	}
-	if sliceRule[brOpen+1:brOpen+2] != ":" {
+	i := ruleStart + brOpen + 1
+
+	for i < len(text) && unicode.IsSpace(rune(text[i])) {
+		i++
+	}
+	if i >= len(text) || text[i] != ':' {
+		return ""
+	}
+	i++ // past ':'
+
+	start := i
+	for i < len(text) && !unicode.IsSpace(rune(text[i])) && text[i] != ']' {
+		i++
+	}
+
+	mode, _, ok := strings.Cut(text[start:i], "-")
+	if !ok {
 		return ""
-	} else {
-		sliceMode := sliceRule[brOpen:]
-		startMode := strings.Index(sliceMode, ":")
-		endMode := strings.Index(sliceMode, "-")
-		if startMode < 0 || endMode < 0 {
-			return ""
-		}
-		mode := sliceRule[brOpen:][startMode:endMode]
-		mode = strings.TrimPrefix(mode, ":")
-		return mode
 	}
+	return mode
 }
 
 // parseAnnotations inspects the 4th element of the binding vector (if present)
@@ -303,348 +519,19 @@ func filterByProgram(entries []BindingEntry, programFilter string) []BindingEntr
 	return out
 }
 
-////////////////////////////////////////////////////////////////////////////////////////////////////
-////////////////////////////////////////////////////////////////////////////////////////////////////
-////////////////////////////////////////////////////////////////////////////////////////////////////
-
-// Package-level variable for the TC prefix.
-// TODO: reuse parse functions to render keyboard
-var TC = "TC"
-
-// ednFile is assumed to be declared externally.
-
-const (
-	DefaultKey = " "
-	OutputDir  = "layouts"
-	OutputFile = "keyboard_layout.md"
-)
-
-// mappingLabels converts an EDN rule key (without any prefix markers) to a friendly label.
-var mappingLabels = map[string]string{
-	"hyphen":              "-",
-	"equal_sign":          "=",
-	"delete_or_backspace": "BACK",
-	"return_or_enter":     "ENTER",
-	"right_shift":         "SHIFT",
-	"right_option":        "ALT",
-	"right_command":       "CMD",
-	"spacebar":            "SPACE",
-	// TODO: add lefts
-}
-
-type KeyConfig struct {
-	key       string
-	kode      string
-	interpret string
-	app       string
-	commented bool
-	term      []Term
-}
-
-type Term struct {
-	app         string
-	description string
-}
-
-////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func parse() {
-	// TODO: high level: parse edn => read file line by line to extract values. mount on structs to indicate config & color
-	// TODO: high level: generate markdown => extract values mounted on structs
-
-	// Initialize the configuration.
-	config := initConfig()
-
-	// For example, assume filePath is passed in or defined here.
-	// filePath := "your_edn_file.edn"
-	if err := updateConfigFromFile(config, flags.ednFile); err != nil {
-		fmt.Printf("Error reading EDN file: %v\n", err)
-		return
-	}
-
-	generateMarkdown(config)
-
-	if flags.verbose {
-		fmt.Printf("Generated layout using TC variable: '%s'\n", TC)
-		fmt.Printf("Output: %s/%s\n", OutputDir, OutputFile)
-	}
-
-}
-
-////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func NewKeyConfig(key string) KeyConfig {
-	return KeyConfig{
-		key:       key,
-		kode:      "",
-		interpret: "",
-		app:       "",
-		commented: false,
-		term:      []Term{},
-	}
-}
-
-func initConfig() map[string]KeyConfig {
-	// Initialize configuration.
-	config := make(map[string]KeyConfig)
-
-	// Initialize letter keys (a-z) with default.
-	for c := 'a'; c <= 'z'; c++ {
-		config[string(c)] = NewKeyConfig(string(c))
-	}
-
-	// Initialize number keys: digits 1-0, dash and equals.
-	digitKeys := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0"}
-	for _, d := range digitKeys {
-		config[d] = NewKeyConfig(d)
-	}
-
-	// Initialize special keys.
-	specialKeys := []string{
-		"hyphen", "equal_sign",
-		"open_bracket", "close_bracket",
-		"semicolon", "quote", "backslash",
-		"comma", "period", "slash",
-		"delete_or_backspace", "return_or_enter",
-		"right_shift", "right_option", "right_command", "spacebar",
-		"left_arrow", "right_arrow", "up_arrow", "down_arrow",
-	}
-	for _, k := range specialKeys {
-		config[k] = NewKeyConfig(k)
-	}
-
-	return config
-}
-
-// updateConfigFromFile reads the EDN file and updates the configuration map.
-// It looks for lines that include "  [:!TC#P" and splits on spaces and semicolons.
-// The key is extracted by splitting the third field on "#P", the fourth whitespace field
-// is assigned to the KeyConfig.kode, and if the line (split by semicolons) has 3 or more fields,
-// the line is considered "commented".
-func updateConfigFromFile(config map[string]KeyConfig, filePath string) error {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return err
-	}
-	lines := strings.Split(string(data), "\n")
-	for _, line := range lines {
-		if strings.Contains(line, "  [:!"+TC+"#P") {
-
-			// Split by whitespace.
-			fieldsSpace := strings.Fields(line)
-			if len(fieldsSpace) < 4 {
-				continue // not enough fields; skip.
-			}
-
-			// keys[2] should be like ":!TC#P<key>".
-			parts := strings.Split(fieldsSpace[0], "#P")
-			if len(parts) < 2 {
-				continue
-			}
-
-			key := parts[1]
-			key = strings.TrimSuffix(key, "]")
-			// Check for comment: if splitting the original line by ';' yields 3 or more fields.
-			fieldsSemi := strings.Split(line, ";")
-
-			hasComment := len(fieldsSemi) >= 3
-
-			// We must fetch the KeyConfig, modify it, then reassign it.
-			if kc, ok := config[key]; ok {
-
-				kode := fieldsSpace[1]
-				kode = strings.TrimSuffix(kode, "]")
-				kode = strings.TrimSuffix(kode, "]")
-				kode = strings.TrimPrefix(kode, "[:")
-				kode = strings.TrimPrefix(kode, "!")
-
-				kc.commented = hasComment
-				if kc.commented {
-					kc.kode = chalk.Bold.TextStyle(chalk.Yellow.Color(kode))
-				} else {
-					kc.kode = chalk.Bold.TextStyle(chalk.Cyan.Color(kode))
-				}
-				config[key] = kc
-			} else {
-				// Optionally, handle keys not present in the map.
-				// For now, we simply ignore them.
-			}
-		}
-	}
-	return nil
-}
-
-func buildCommentMap(filePath string) map[string]bool {
-	commentMap := make(map[string]bool)
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return commentMap // return an empty map on error
-	}
-	lines := strings.Split(string(data), "\n")
-	// This regex captures the key following "[:!TC#P" until a space, semicolon, or closing bracket.
-	for _, line := range lines {
-		if strings.Contains(line, "  [:!"+TC+"#P") {
-			keys := strings.Split(line, " ")
-			if len(keys) >= 2 {
-				key := strings.Split(keys[2], "#P")[1]
-				fields := strings.Split(line, ";")
-				// If there are more than three fields, we consider the rule as having an extra comment.
-				hasComment := len(fields) >= 3
-				commentMap[key] = hasComment
-			}
-		}
-	}
-	return commentMap
-}
-
-// derivedKey converts a target like "Phyphen" or "Popen_bracket" into the final config key.
-func derivedKey(target string) string {
-	if strings.HasPrefix(target, "P") {
-		return strings.TrimPrefix(target, "P")
-	}
-	return target
-}
-
-// extractMappingComments extracts mapping comments using a regular expression that captures the key name.
-func extractMappingComments(filePath string) []string {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return nil
-	}
-	rawText := string(data)
-	lines := strings.Split(rawText, "\n")
-	var comments []string
-	for _, line := range lines {
-		if strings.Contains(line, "[:!TC#P") {
-			parts := strings.Split(line, ";")
-			if len(parts) >= 3 {
-				commentVal := strings.TrimSpace(parts[1])
-				commentKey := strings.TrimSpace(parts[2])
-				if commentVal != "" && commentKey != "" {
-					comments = append(comments, fmt.Sprintf("%s => %s", commentKey, commentVal))
-				}
-			}
-		}
-	}
-	return comments
-}
-
-func generateMarkdown(config map[string]KeyConfig) {
-	if err := os.MkdirAll(OutputDir, 0755); err != nil {
-		panic(fmt.Sprintf("Error creating output directory: %v", err))
-	}
+// loadAllEntries resolves, parses, and program-filters the configured EDN
+// sources, with no --render mode applied. Shared by displayCmd (which
+// applies its own FULL/EMPTY/DEFAULT/TREE filter on top) and lintCmd (which
+// needs every entry, including empty ones, to detect shadowed bindings).
+func loadAllEntries() ([]BindingEntry, error) {
+	paths := resolveEDNFiles(flags.ednFile, flags.rootDir)
 
-	file, err := os.Create(fmt.Sprintf("%s/%s", OutputDir, OutputFile))
+	allEntries, err := parseEDNFiles(paths)
 	if err != nil {
-		panic(fmt.Sprintf("Error creating output file: %v", err))
-	}
-	defer file.Close()
-
-	center := func(text string, width int) string {
-		if len(text) >= width {
-			return text
-		}
-		padding := (width - len(text)) / 2
-		return fmt.Sprintf("%*s%s%*s", padding, "", text, padding, "")
-	}
-
-	markdownStart := fmt.Sprintf(`# Dynamic Keyboard Layout
-*Generated*
-
-`)
-	codeFenceStart := "```markdown\n"
-	codeFenceEnd := "```\n"
-
-	// Build the dynamic number row. The final cell uses the value for delete_or_backspace.
-	numberRow := fmt.Sprintf("| %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s |",
-		"~ `",
-		config["1"].kode, config["2"].kode, config["3"].kode,
-		config["4"].kode, config["5"].kode, config["6"].kode,
-		config["7"].kode, config["8"].kode, config["9"].kode,
-		config["0"].kode, config["hyphen"].kode, config["equal_sign"].kode,
-		config["delete_or_backspace"].kode,
-	)
-
-	topBorder := "┌─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬─────┬───────────┐\n"
-	secondRow := fmt.Sprintf("| TAB | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s |\n",
-		center(config["q"].kode, 3), center(config["w"].kode, 3), center(config["e"].kode, 3),
-		center(config["r"].kode, 3), center(config["t"].kode, 3), center(config["y"].kode, 3),
-		center(config["u"].kode, 3), center(config["i"].kode, 3), center(config["o"].kode, 3),
-		center(config["p"].kode, 3), config["open_bracket"].kode, config["close_bracket"].kode,
-		center(config["backslash"].kode, 8),
-	)
-
-	thirdRow := fmt.Sprintf("| CAPS | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s |      %s      |\n",
-		center(config["a"].kode, 3), center(config["s"].kode, 3), center(config["d"].kode, 3),
-		center(config["f"].kode, 3), center(config["g"].kode, 3), center(config["h"].kode, 3),
-		center(config["j"].kode, 3), center(config["k"].kode, 3), center(config["l"].kode, 3),
-		config["semicolon"].kode, config["quote"].kode, center(config["return_or_enter"].kode, 8),
-	)
-	fourthRow := fmt.Sprintf("| SHIFT  | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s |     %s     |\n",
-		center(config["z"].kode, 3), center(config["x"].kode, 3), center(config["c"].kode, 3),
-		center(config["v"].kode, 3), center(config["b"].kode, 3), center(config["n"].kode, 3),
-		center(config["m"].kode, 3), config["comma"].kode, config["period"].kode,
-		config["slash"].kode, center(config["right_shift"].kode, 8),
-	)
-	fifthRow := fmt.Sprintf("| CTL | ALT | CMD │               %s               │ %s | %s │\n",
-		center(config["spacebar"].kode, 16),
-		config["right_command"].kode,
-		config["right_option"].kode,
-	)
-	bottomBorder := "└─────┴─────┴─────┴─────┴─────┴─────┴─────┴─────┴─────┴─────┴─────┴─────┴─────┴───────────┘\n"
-
-	finalLayout := topBorder + numberRow + "\n" + secondRow + thirdRow + fourthRow + fifthRow + bottomBorder
-
-	// activeMappingsSection := fmt.Sprintf("\n### Active Mappings\n- **Letters**: %s\n- **Specials**: %s (SPACE), %s (ENTER)\n- **Arrows**: %s\n- **TC Variable**: '%s' (change in script)\n",
-	// 	getActiveMappings(config.Letters),
-	// 	config.SpecialKeys["spacebar"],
-	// 	config.SpecialKeys["return_or_enter"],
-	// 	getArrowMappings(config),
-	// 	config.UsedTcPrefix,
-	// )
-
-	mappingComments := extractMappingComments(flags.ednFile)
-	mappingCommentsSection := ""
-	if len(mappingComments) > 0 {
-		mappingCommentsSection = "\n### Mapping Comments\n"
-		for _, comment := range mappingComments {
-			mappingCommentsSection += "- " + comment + "\n"
-		}
+		return nil, err
 	}
 
-	finalContent := markdownStart + codeFenceStart + finalLayout + codeFenceEnd + mappingCommentsSection
-
-	if _, writeErr := file.WriteString(finalContent); writeErr != nil {
-		panic(fmt.Sprintf("Error writing to output file: %v", writeErr))
-	}
+	return filterByProgram(allEntries, flags.program), nil
 }
 
-// func getActiveMappings(letters map[string]string) string {
-// 	var active []string
-// 	for c := 'a'; c <= 'z'; c++ {
-// 		letter := string(c)
-// 		if letters[letter] != DefaultKey {
-// 			active = append(active, fmt.Sprintf("%s: %s", letter, letters[letter]))
-// 		}
-// 	}
-// 	if len(active) == 0 {
-// 		return "None"
-// 	}
-// 	return strings.Join(active, ", ")
-// }
-
-// func getArrowMappings(config KeyboardConfig) string {
-// 	arrows := []string{"left_arrow", "right_arrow", "up_arrow", "down_arrow"}
-// 	var mappings []string
-// 	for _, arrow := range arrows {
-// 		if val, ok := config.SpecialKeys[arrow]; ok && val != DefaultKey {
-// 			mappings = append(mappings, fmt.Sprintf("%s: %s", arrow, val))
-// 		}
-// 	}
-// 	if len(mappings) == 0 {
-// 		return "None"
-// 	}
-// 	return strings.Join(mappings, ", ")
-// }
-
 ////////////////////////////////////////////////////////////////////////////////////////////////////
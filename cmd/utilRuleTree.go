@@ -0,0 +1,249 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"strings"
+	"unicode"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// RuleTree is one mode's binding entries plus its injection layers: Goku's
+// :layers and :simlayers, each of which is itself a full nested rule set
+// that can carry further :layers/:simlayers of its own. parseBindingEntries
+// and parseEDNFile stay flat (every existing caller — display, lint,
+// convert, lsp, watch, diff — depends on that []BindingEntry shape); this
+// is an additive entry point for callers that want to walk the layer
+// structure instead, e.g. a future `babel display --layer tc/hold`.
+type RuleTree struct {
+	Mode     string
+	Entries  []BindingEntry
+	Children []*RuleTree
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ParseRuleTree reads and parses path the way parseEDNFile does, but returns
+// a RuleTree instead of a flat slice.
+func ParseRuleTree(path string) (*RuleTree, error) {
+	text := loadEDNFile(path)
+	tree := BuildRuleTree(text)
+	tree.setPath(path)
+	return tree, nil
+}
+
+func (t *RuleTree) setPath(path string) {
+	for i := range t.Entries {
+		t.Entries[i].Path = path
+	}
+	for _, c := range t.Children {
+		c.setPath(path)
+	}
+}
+
+// BuildRuleTree parses text's top-level :rules the same way
+// parseBindingEntries does, then recurses into every :layers/:simlayers
+// map as a child RuleTree keyed by its layer name. The top-level scan
+// excludes whatever byte ranges :layers/:simlayers occupy, so a sublayer's
+// entries are attributed to their own layer instead of being double-counted
+// under the root mode.
+func BuildRuleTree(text string) *RuleTree {
+	mode := extractMode(text)
+	rootText := blankSpans(text, nestedLayerSpans(text))
+
+	root := &RuleTree{
+		Mode:    mode,
+		Entries: parseBindingEntries(rootText, mode),
+	}
+	root.Children = append(parseNestedLayers(text, ":layers"), parseNestedLayers(text, ":simlayers")...)
+	return root
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Walk visits every entry across tree and its descendants, depth-first,
+// passing the enclosing layer path (outermost mode first) to fn. Walk
+// returns false, stopping immediately, as soon as fn does — including
+// skipping any sibling subtrees not yet visited.
+func (t *RuleTree) Walk(fn func(entry *BindingEntry, path []string) bool) bool {
+	return t.walk(nil, fn)
+}
+
+func (t *RuleTree) walk(path []string, fn func(entry *BindingEntry, path []string) bool) bool {
+	here := path
+	if t.Mode != "" {
+		here = append(append([]string{}, path...), t.Mode)
+	}
+
+	for i := range t.Entries {
+		if !fn(&t.Entries[i], here) {
+			return false
+		}
+	}
+	for _, c := range t.Children {
+		if !c.walk(here, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// namedForm is one "keyword [...]" or "keyword {...}" pair scanned out of a
+// :layers/:simlayers map body, e.g. :tc-hold [...] inside {:tc-hold [...]}.
+type namedForm struct {
+	name string
+	text string
+}
+
+// scanKeywordForms walks body (the inside of a {...} map, braces excluded)
+// and returns one namedForm per "keyword value" pair it finds, in order.
+// Non-form values (bare keywords, strings, numbers) are skipped, since only
+// nested rule sets are meaningful to a RuleTree.
+func scanKeywordForms(body string) []namedForm {
+	var forms []namedForm
+	i := 0
+	for i < len(body) {
+		for i < len(body) && unicode.IsSpace(rune(body[i])) {
+			i++
+		}
+		if i >= len(body) {
+			break
+		}
+		if body[i] != ':' {
+			i++
+			continue
+		}
+
+		start := i
+		i++
+		for i < len(body) && !unicode.IsSpace(rune(body[i])) {
+			i++
+		}
+		name := strings.TrimPrefix(body[start:i], ":")
+
+		for i < len(body) && unicode.IsSpace(rune(body[i])) {
+			i++
+		}
+		if i >= len(body) {
+			break
+		}
+
+		var formEnd int
+		var ok bool
+		switch body[i] {
+		case '[':
+			formEnd, ok = scanBalanced(body, i, '[', ']')
+		case '{':
+			formEnd, ok = scanBalanced(body, i, '{', '}')
+		default:
+			continue // not a form this scan cares about
+		}
+		if !ok {
+			break
+		}
+
+		forms = append(forms, namedForm{name: name, text: body[i:formEnd]})
+		i = formEnd
+	}
+	return forms
+}
+
+// parseNestedLayers finds keyword's map literal (":layers {...}" or
+// ":simlayers {...}") in text and returns one RuleTree child per entry
+// inside it, recursing into any further :layers/:simlayers nested within
+// each child's own text.
+func parseNestedLayers(text, keyword string) []*RuleTree {
+	idx := strings.Index(text, keyword)
+	if idx < 0 {
+		return nil
+	}
+
+	i := idx + len(keyword)
+	for i < len(text) && unicode.IsSpace(rune(text[i])) {
+		i++
+	}
+	if i >= len(text) || text[i] != '{' {
+		return nil
+	}
+
+	end, ok := scanBalanced(text, i, '{', '}')
+	if !ok {
+		return nil
+	}
+	body := text[i+1 : end-1]
+
+	var children []*RuleTree
+	for _, f := range scanKeywordForms(body) {
+		child := &RuleTree{
+			Mode:    f.name,
+			Entries: parseBindingEntries(f.text, f.name),
+		}
+		child.Children = append(parseNestedLayers(f.text, ":layers"), parseNestedLayers(f.text, ":simlayers")...)
+		children = append(children, child)
+	}
+	return children
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// nestedLayerSpans returns the byte range of every :layers/:simlayers map
+// literal in text, for blankSpans to carve out of the root-level scan.
+func nestedLayerSpans(text string) [][2]int {
+	var spans [][2]int
+	for _, kw := range []string{":layers", ":simlayers"} {
+		idx := strings.Index(text, kw)
+		if idx < 0 {
+			continue
+		}
+		i := idx + len(kw)
+		for i < len(text) && unicode.IsSpace(rune(text[i])) {
+			i++
+		}
+		if i >= len(text) || text[i] != '{' {
+			continue
+		}
+		end, ok := scanBalanced(text, i, '{', '}')
+		if !ok {
+			continue
+		}
+		spans = append(spans, [2]int{idx, end})
+	}
+	return spans
+}
+
+// blankSpans replaces every non-newline byte within spans with a space,
+// so a later scan skips that text entirely while every other byte keeps
+// its original offset and line number — parsec.Position tracking stays
+// accurate across the blanked-out region.
+func blankSpans(text string, spans [][2]int) string {
+	b := []byte(text)
+	for _, sp := range spans {
+		for i := sp[0]; i < sp[1]; i++ {
+			if b[i] != '\n' {
+				b[i] = ' '
+			}
+		}
+	}
+	return string(b)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
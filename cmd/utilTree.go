@@ -0,0 +1,160 @@
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+package cmd
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// chordNode is one step of a tokenised key sequence. Leaves (and any node
+// reached mid-sequence that is itself bound) carry the ProgramActions that
+// fire at that point.
+type chordNode struct {
+	children map[string]*chordNode
+	actions  []ProgramAction
+}
+
+func newChordNode() *chordNode {
+	return &chordNode{children: make(map[string]*chordNode)}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// tokenizeSequence splits entry.Sequence on whitespace, falling back to the
+// single-step [modifier runes..., key] form when no multi-step sequence
+// was recorded.
+func tokenizeSequence(e BindingEntry) []string {
+	if strings.TrimSpace(e.Sequence) != "" {
+		return strings.Fields(e.Sequence)
+	}
+
+	var toks []string
+	for _, r := range e.Binding.Modifier {
+		toks = append(toks, string(r))
+	}
+	toks = append(toks, e.Binding.Key)
+	return toks
+}
+
+// buildChordTree groups entries by their common tokenised prefix.
+func buildChordTree(entries []BindingEntry) *chordNode {
+	root := newChordNode()
+	for _, e := range entries {
+		node := root
+		for _, tok := range tokenizeSequence(e) {
+			next, ok := node.children[tok]
+			if !ok {
+				next = newChordNode()
+				node.children[tok] = next
+			}
+			node = next
+		}
+		node.actions = append(node.actions, e.Actions...)
+	}
+	return root
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// sortedChildren returns this node's child tokens ordered per --sort.
+func sortedChildren(node *chordNode, sortBy string) []string {
+	toks := make([]string, 0, len(node.children))
+	for tok := range node.children {
+		toks = append(toks, tok)
+	}
+	sort.Strings(toks)
+	return toks
+}
+
+// sortedActions orders a leaf's ProgramActions per --sort.
+func sortedActions(actions []ProgramAction, sortBy string) []ProgramAction {
+	out := append([]ProgramAction(nil), actions...)
+	sort.Slice(out, func(i, j int) bool {
+		switch strings.ToLower(sortBy) {
+		case "action":
+			return out[i].Action < out[j].Action
+		default: // "program"
+			return out[i].Program < out[j].Program
+		}
+	})
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// renderChordTree prints the trie built from entries, in the terminal's
+// box-drawing form or as nested Markdown <details>/<summary> blocks.
+func renderChordTree(entries []BindingEntry, markdown bool) {
+	root := buildChordTree(entries)
+	if markdown {
+		renderChordMarkdown(root, flags.sortBy, 0)
+		return
+	}
+	renderChordText(root, flags.sortBy, "")
+}
+
+// renderChordText walks the trie depth-first, printing each level with the
+// usual ├──/└── connectors.
+func renderChordText(node *chordNode, sortBy, prefix string) {
+	toks := sortedChildren(node, sortBy)
+	for i, tok := range toks {
+		child := node.children[tok]
+		last := i == len(toks)-1
+
+		connector := "├── "
+		nextPrefix := prefix + "│   "
+		if last {
+			connector = "└── "
+			nextPrefix = prefix + "    "
+		}
+
+		fmt.Print(prefix + connector + tok)
+		if len(child.actions) > 0 {
+			fmt.Print(" " + formatChordActions(child.actions, sortBy))
+		}
+		fmt.Println()
+
+		renderChordText(child, sortBy, nextPrefix)
+	}
+}
+
+// renderChordMarkdown walks the trie, emitting a <details><summary> block
+// per non-leaf token so the chord namespace collapses in rendered Markdown.
+func renderChordMarkdown(node *chordNode, sortBy string, depth int) {
+	toks := sortedChildren(node, sortBy)
+	for _, tok := range toks {
+		child := node.children[tok]
+
+		label := tok
+		if len(child.actions) > 0 {
+			label += " " + formatChordActions(child.actions, sortBy)
+		}
+
+		if len(child.children) == 0 {
+			fmt.Printf("%s- %s\n", strings.Repeat("  ", depth), label)
+			continue
+		}
+
+		fmt.Printf("%s<details><summary>%s</summary>\n\n", strings.Repeat("  ", depth), label)
+		renderChordMarkdown(child, sortBy, depth+1)
+		fmt.Printf("%s</details>\n\n", strings.Repeat("  ", depth))
+	}
+}
+
+// formatChordActions renders a leaf's terminal actions as "[prog action, ...]".
+func formatChordActions(actions []ProgramAction, sortBy string) string {
+	sorted := sortedActions(actions, sortBy)
+	parts := make([]string, len(sorted))
+	for i, a := range sorted {
+		parts[i] = fmt.Sprintf("%s %s", a.Program, a.Action)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
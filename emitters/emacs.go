@@ -0,0 +1,56 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package emitters
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// emacsEmitter renders global-set-key sexprs for an init.el.
+type emacsEmitter struct{}
+
+func (emacsEmitter) Name() string { return "emacs" }
+
+func (emacsEmitter) Header(w io.Writer, headers []string) {
+	writeHeaders(w, headers)
+}
+
+func (emacsEmitter) Emit(w io.Writer, key, val string) {
+	fmt.Fprintf(w, "(global-set-key (kbd %q) %s)\n", key, val)
+}
+
+func (emacsEmitter) Footer(w io.Writer) {}
+
+func (emacsEmitter) FormatValue(raw string) string {
+	cmd := strings.Trim(strings.TrimSpace(raw), "[]")
+	cmd = strings.TrimPrefix(cmd, ":sh ")
+	return fmt.Sprintf("(lambda () (interactive) (shell-command %q))", cmd)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	Register(emacsEmitter{})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,92 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package emitters holds one Emitter per output target (helix, micro,
+// neovim, vscode, emacs, ...). Adding a new editor is a matter of adding a
+// file that registers an Emitter in init() — cmd/cmdInterpret.go never
+// needs to change.
+package emitters
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Emitter renders a target's bindings in its native config syntax.
+type Emitter interface {
+	// Name is the --program value this emitter answers to, e.g. "helix-normal".
+	Name() string
+
+	// Header writes any preamble that must precede the bindings, followed
+	// by headers — the program's Headers lines from programs.toml, if any
+	// — so a user's custom header config still takes effect on top of
+	// whatever structural preamble (braces, section markers, ...) this
+	// emitter itself always needs.
+	Header(w io.Writer, headers []string)
+
+	// Emit writes a single key/value binding line.
+	Emit(w io.Writer, key, val string)
+
+	// Footer writes any closing material that must follow the bindings.
+	Footer(w io.Writer)
+
+	// FormatValue converts a raw EDN action command into this target's
+	// value syntax (e.g. a TOML array, a bare string, a Lua table).
+	FormatValue(raw string) string
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var registry = map[string]Emitter{}
+
+// Register adds an Emitter to the registry, keyed by its Name(). Built-in
+// emitters call this from their own init().
+func Register(e Emitter) {
+	registry[e.Name()] = e
+}
+
+// Lookup returns the Emitter registered for target, if any.
+func Lookup(target string) (Emitter, bool) {
+	e, ok := registry[target]
+	return e, ok
+}
+
+// Names returns every registered target name, sorted, for tab completion.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeHeaders writes each of headers on its own line. Shared by every
+// Header implementation so programs.toml's per-program Headers config
+// takes effect the same way regardless of target syntax.
+func writeHeaders(w io.Writer, headers []string) {
+	for _, line := range headers {
+		fmt.Fprintln(w, line)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
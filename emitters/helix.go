@@ -0,0 +1,89 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package emitters
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// helixEmitter renders one [keys.<mode>] section of Helix's config.toml.
+type helixEmitter struct {
+	name    string
+	section string // e.g. "[keys.normal]"; empty for helix-common
+}
+
+func (h helixEmitter) Name() string { return h.name }
+
+func (h helixEmitter) Header(w io.Writer, headers []string) {
+	if h.section != "" {
+		fmt.Fprintln(w, h.section)
+	}
+	writeHeaders(w, headers)
+}
+
+func (h helixEmitter) Emit(w io.Writer, key, val string) {
+	fmt.Fprintf(w, "%s = %s\n", key, val)
+}
+
+func (h helixEmitter) Footer(w io.Writer) {}
+
+func (h helixEmitter) FormatValue(raw string) string {
+	return tomlList(raw)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	Register(helixEmitter{name: "helix-common"})
+	Register(helixEmitter{name: "helix-insert", section: "[keys.insert]"})
+	Register(helixEmitter{name: "helix-normal", section: "[keys.normal]"})
+	Register(helixEmitter{name: "helix-select", section: "[keys.select]"})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// tomlList converts an EDN-style list (e.g. `[:sh "foo"]`) into a TOML
+// array literal, shared by every emitter that renders a Helix-flavoured
+// array of strings.
+func tomlList(raw string) string {
+	inner := strings.TrimSpace(raw)
+	inner = strings.TrimPrefix(inner, "[")
+	inner = strings.TrimSuffix(inner, "]")
+
+	if strings.HasPrefix(inner, ":sh ") {
+		return fmt.Sprintf("[%q]", inner)
+	}
+
+	if inner == "" {
+		return "[]"
+	}
+
+	parts := strings.Fields(inner)
+	quoted := make([]string, len(parts))
+	for i, p := range parts {
+		quoted[i] = fmt.Sprintf("%q", p)
+	}
+	return "[" + strings.Join(quoted, ",") + "]"
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,58 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package emitters
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// microEmitter renders micro's bindings.json, a flat object of
+// key -> command strings.
+type microEmitter struct{}
+
+func (microEmitter) Name() string { return "micro" }
+
+func (microEmitter) Header(w io.Writer, headers []string) {
+	fmt.Fprintln(w, "{")
+	writeHeaders(w, headers)
+}
+
+func (microEmitter) Emit(w io.Writer, key, val string) {
+	fmt.Fprintf(w, "  %q: %q,\n", key, val)
+}
+
+func (microEmitter) Footer(w io.Writer) {
+	fmt.Fprintln(w, "}")
+}
+
+func (microEmitter) FormatValue(raw string) string {
+	return strings.Trim(raw, "[]")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	Register(microEmitter{})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,56 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package emitters
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// neovimEmitter renders Lua vim.keymap.set calls for an init.lua.
+type neovimEmitter struct{}
+
+func (neovimEmitter) Name() string { return "neovim" }
+
+func (neovimEmitter) Header(w io.Writer, headers []string) {
+	writeHeaders(w, headers)
+}
+
+func (neovimEmitter) Emit(w io.Writer, key, val string) {
+	fmt.Fprintf(w, "vim.keymap.set(\"n\", %q, %s)\n", key, val)
+}
+
+func (neovimEmitter) Footer(w io.Writer) {}
+
+func (neovimEmitter) FormatValue(raw string) string {
+	cmd := strings.Trim(strings.TrimSpace(raw), "[]")
+	cmd = strings.TrimPrefix(cmd, ":sh ")
+	return fmt.Sprintf("\"<cmd>%s<cr>\"", cmd)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	Register(neovimEmitter{})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,72 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package emitters
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// vscodeEmitter renders a VS Code keybindings.json array of
+// {key, command, when} objects.
+type vscodeEmitter struct {
+	first bool
+}
+
+func (v *vscodeEmitter) Name() string { return "vscode" }
+
+// Header opens the array and writes any configured headers. headers must
+// themselves be complete, comma-terminated array entries (this is a JSON
+// array, not a line-oriented format) — Emit no longer needs to prepend a
+// separating comma once any have been written.
+func (v *vscodeEmitter) Header(w io.Writer, headers []string) {
+	fmt.Fprintln(w, "[")
+	writeHeaders(w, headers)
+	v.first = len(headers) == 0
+}
+
+func (v *vscodeEmitter) Emit(w io.Writer, key, val string) {
+	if !v.first {
+		fmt.Fprintln(w, ",")
+	}
+	v.first = false
+	fmt.Fprintf(w, "  { \"key\": %q, \"command\": %s }", key, val)
+}
+
+func (v *vscodeEmitter) Footer(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "]")
+}
+
+func (v *vscodeEmitter) FormatValue(raw string) string {
+	cmd := strings.Trim(strings.TrimSpace(raw), "[]")
+	cmd = strings.TrimPrefix(cmd, ":sh ")
+	return fmt.Sprintf("%q", cmd)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func init() {
+	Register(&vscodeEmitter{})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,51 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package bindings models the layer/trigger/action tree shared by every
+// babel source and sink (EDN, Karabiner JSON, ...), independent of any
+// particular cobra command, so encoders can be added without reaching
+// back into cmd.
+package bindings
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Trigger is a single key chord: an optional layer Mode, a modifier-rune
+// cluster (e.g. "OT"), and the key itself.
+type Trigger struct {
+	Mode     string
+	Modifier string
+	Key      string
+}
+
+// Action is one program-specific effect a binding fires.
+type Action struct {
+	Program string
+	Action  string
+	Command string
+}
+
+// Entry is one rule: the trigger that invokes it, the resolved binding,
+// an optional multi-step Sequence, and the actions it fans out to.
+type Entry struct {
+	Trigger     Trigger
+	Binding     Trigger
+	Sequence    string
+	Actions     []Action
+	Annotations map[string][]string // e.g. "alone" -> ["f13"]
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
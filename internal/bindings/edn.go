@@ -0,0 +1,58 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package bindings
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// EncodeEDN renders entries as a Goku-style `:rules [...]` vector, the
+// inverse of babel's EDN extractor. It is a plain, hand-rolled writer —
+// matching the repo's own string-based EDN handling — not a general EDN
+// serializer.
+func EncodeEDN(entries []Entry) string {
+	var b strings.Builder
+	b.WriteString(":rules\n[\n")
+	for _, e := range entries {
+		fmt.Fprintf(&b, "  ^{:doc/actions [%s]}\n", encodeActions(e.Actions))
+		fmt.Fprintf(&b, "  [:!%s%s %s]\n\n", e.Trigger.Modifier, e.Trigger.Key, encodeBinding(e.Binding))
+	}
+	b.WriteString("]\n")
+	return b.String()
+}
+
+func encodeActions(actions []Action) string {
+	parts := make([]string, len(actions))
+	for i, a := range actions {
+		parts[i] = fmt.Sprintf(
+			"{:program %q :action %q :exec %q}",
+			a.Program, a.Action, a.Command,
+		)
+	}
+	return strings.Join(parts, " ")
+}
+
+func encodeBinding(b Trigger) string {
+	return fmt.Sprintf(":!%s%s", b.Modifier, b.Key)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
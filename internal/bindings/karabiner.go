@@ -0,0 +1,309 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package bindings
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Modifiers is Karabiner's `from.modifiers` object.
+type Modifiers struct {
+	Mandatory []string `json:"mandatory,omitempty"`
+}
+
+// From is Karabiner's `from` manipulator clause.
+type From struct {
+	KeyCode   string     `json:"key_code"`
+	Modifiers *Modifiers `json:"modifiers,omitempty"`
+}
+
+// To is Karabiner's `to` manipulator clause. Only one of KeyCode or
+// ShellCommand is set per entry.
+type To struct {
+	KeyCode      string `json:"key_code,omitempty"`
+	ShellCommand string `json:"shell_command,omitempty"`
+}
+
+// Condition is one Karabiner manipulator condition. Babel only emits the
+// frontmost_application_if/unless variants, since those are the only ones a
+// ProgramAction's Program regex maps onto.
+type Condition struct {
+	Type              string   `json:"type"`
+	BundleIdentifiers []string `json:"bundle_identifiers,omitempty"`
+}
+
+// Manipulator is one Karabiner complex_modifications manipulator. ToIfAlone
+// and ToAfterKeyUp mirror babel's EDN "alone"/"afterup" annotations.
+type Manipulator struct {
+	Type         string      `json:"type"`
+	From         From        `json:"from"`
+	To           []To        `json:"to"`
+	ToIfAlone    []To        `json:"to_if_alone,omitempty"`
+	ToAfterKeyUp []To        `json:"to_after_key_up,omitempty"`
+	Conditions   []Condition `json:"conditions,omitempty"`
+}
+
+// Rule is one Karabiner complex_modifications rule.
+type Rule struct {
+	Description  string        `json:"description"`
+	Manipulators []Manipulator `json:"manipulators"`
+}
+
+// ComplexModifications is a full karabiner.json complex_modifications
+// document, ready to drop into a Karabiner-Elements profile.
+type ComplexModifications struct {
+	Title string `json:"title"`
+	Rules []Rule `json:"rules"`
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// modifierKeyCodes mirrors the O/E/T/W/C/Q/S/R rune convention used
+// throughout babel's EDN parsing (see cmd's rg regex table).
+var modifierKeyCodes = map[rune]string{
+	'O': "left_option",
+	'E': "right_option",
+	'T': "left_control",
+	'W': "right_control",
+	'C': "left_command",
+	'Q': "right_command",
+	'S': "left_shift",
+	'R': "right_shift",
+}
+
+var keyCodeModifiers = func() map[string]rune {
+	inv := make(map[string]rune, len(modifierKeyCodes))
+	for r, name := range modifierKeyCodes {
+		inv[name] = r
+	}
+	return inv
+}()
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// EncodeKarabiner renders entries as a karabiner.json complex_modifications
+// document titled title.
+func EncodeKarabiner(title string, entries []Entry) ComplexModifications {
+	cm := ComplexModifications{Title: title}
+	for _, e := range entries {
+		manip := Manipulator{
+			Type: "basic",
+			From: From{KeyCode: e.Binding.Key},
+		}
+		if mods := modifierNames(e.Binding.Modifier); len(mods) > 0 {
+			manip.From.Modifiers = &Modifiers{Mandatory: mods}
+		}
+		for _, a := range e.Actions {
+			manip.To = append(manip.To, actionToTo(a))
+		}
+		manip.ToIfAlone = annotationTo(e.Annotations["alone"])
+		manip.ToAfterKeyUp = annotationTo(e.Annotations["afterup"])
+		manip.Conditions = actionConditions(e.Actions)
+
+		cm.Rules = append(cm.Rules, Rule{
+			Description:  describeEntry(e),
+			Manipulators: []Manipulator{manip},
+		})
+	}
+	return cm
+}
+
+// DecodeKarabiner parses a karabiner.json complex_modifications document
+// back into Entries.
+func DecodeKarabiner(data []byte) ([]Entry, error) {
+	var cm ComplexModifications
+	if err := json.Unmarshal(data, &cm); err != nil {
+		return nil, fmt.Errorf("decode karabiner json: %w", err)
+	}
+
+	var entries []Entry
+	for _, rule := range cm.Rules {
+		for _, m := range rule.Manipulators {
+			entry := Entry{
+				Binding: Trigger{
+					Modifier: modifierRunes(m.From.Modifiers),
+					Key:      m.From.KeyCode,
+				},
+			}
+			for _, to := range m.To {
+				entry.Actions = append(entry.Actions, toToAction(to))
+			}
+			if prog, ok := conditionsProgram(m.Conditions); ok {
+				for i := range entry.Actions {
+					entry.Actions[i].Program = prog
+				}
+			}
+			if anns := annotationsFromTo(m.ToIfAlone, m.ToAfterKeyUp); len(anns) > 0 {
+				entry.Annotations = anns
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func modifierNames(mods string) []string {
+	var out []string
+	for _, r := range mods {
+		if name, ok := modifierKeyCodes[r]; ok {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func modifierRunes(mods *Modifiers) string {
+	if mods == nil {
+		return ""
+	}
+	var out strings.Builder
+	for _, name := range mods.Mandatory {
+		if r, ok := keyCodeModifiers[name]; ok {
+			out.WriteRune(r)
+		}
+	}
+	return out.String()
+}
+
+// annotationTo converts one "alone"/"afterup" annotation's raw values (each
+// a command string, the same shape an Action.Command carries) into a
+// to_if_alone/to_after_key_up clause list. Returns nil for an absent
+// annotation, matching the []To zero value Manipulator already expects.
+func annotationTo(vals []string) []To {
+	if len(vals) == 0 {
+		return nil
+	}
+	out := make([]To, len(vals))
+	for i, v := range vals {
+		out[i] = actionToTo(Action{Command: v})
+	}
+	return out
+}
+
+// annotationsFromTo is annotationTo's inverse for both annotation kinds.
+func annotationsFromTo(alone, afterUp []To) map[string][]string {
+	anns := make(map[string][]string)
+	if vals := toActionCommands(alone); len(vals) > 0 {
+		anns["alone"] = vals
+	}
+	if vals := toActionCommands(afterUp); len(vals) > 0 {
+		anns["afterup"] = vals
+	}
+	return anns
+}
+
+func toActionCommands(tos []To) []string {
+	if len(tos) == 0 {
+		return nil
+	}
+	out := make([]string, len(tos))
+	for i, to := range tos {
+		out[i] = toToAction(to).Command
+	}
+	return out
+}
+
+// actionConditions derives frontmost_application_if/unless conditions from
+// each action's Program: a bare program string is a bundle-identifier regex
+// Karabiner should match against, a "!"-prefixed one negates the match.
+// Duplicate and empty Program values are dropped.
+func actionConditions(actions []Action) []Condition {
+	var ifPatterns, unlessPatterns []string
+	seen := make(map[string]bool)
+	for _, a := range actions {
+		prog := strings.TrimSpace(a.Program)
+		if prog == "" || seen[prog] {
+			continue
+		}
+		seen[prog] = true
+		if strings.HasPrefix(prog, "!") {
+			unlessPatterns = append(unlessPatterns, strings.TrimPrefix(prog, "!"))
+		} else {
+			ifPatterns = append(ifPatterns, prog)
+		}
+	}
+
+	var conds []Condition
+	if len(ifPatterns) > 0 {
+		conds = append(conds, Condition{Type: "frontmost_application_if", BundleIdentifiers: ifPatterns})
+	}
+	if len(unlessPatterns) > 0 {
+		conds = append(conds, Condition{Type: "frontmost_application_unless", BundleIdentifiers: unlessPatterns})
+	}
+	return conds
+}
+
+// conditionsProgram is actionConditions' inverse, best-effort: Karabiner
+// conditions apply to a whole manipulator while Program is per-action, so
+// only the first bundle identifier of the first condition is recovered.
+func conditionsProgram(conds []Condition) (string, bool) {
+	for _, c := range conds {
+		if len(c.BundleIdentifiers) == 0 {
+			continue
+		}
+		switch c.Type {
+		case "frontmost_application_if":
+			return c.BundleIdentifiers[0], true
+		case "frontmost_application_unless":
+			return "!" + c.BundleIdentifiers[0], true
+		}
+	}
+	return "", false
+}
+
+// actionToTo turns a raw EDN action command into a Karabiner `to` clause:
+// a `:sh "..."` command becomes shell_command, anything else is treated as
+// a bare key_code (e.g. a simultaneous keystroke).
+func actionToTo(a Action) To {
+	cmd := strings.TrimSpace(a.Command)
+	if strings.HasPrefix(cmd, ":sh ") {
+		shell := strings.TrimSpace(strings.TrimPrefix(cmd, ":sh "))
+		shell = strings.Trim(shell, `"`)
+		return To{ShellCommand: shell}
+	}
+	return To{KeyCode: strings.TrimPrefix(cmd, ":")}
+}
+
+// toToAction is actionToTo's inverse, used when reading a karabiner.json
+// back in.
+func toToAction(to To) Action {
+	if to.ShellCommand != "" {
+		return Action{Command: fmt.Sprintf(":sh %q", to.ShellCommand)}
+	}
+	return Action{Command: ":" + to.KeyCode}
+}
+
+func describeEntry(e Entry) string {
+	if len(e.Actions) == 0 {
+		return e.Binding.Key
+	}
+	parts := make([]string, len(e.Actions))
+	for i, a := range e.Actions {
+		parts[i] = strings.TrimSpace(a.Program + " " + a.Action)
+	}
+	return strings.Join(parts, ", ")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
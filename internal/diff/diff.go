@@ -0,0 +1,174 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package diff compares two EDN revisions' parsed bindings, independent of
+// any particular cobra command or render format, so babel diff can turn a
+// layout change into a reviewable artifact instead of an opaque EDN edit.
+package diff
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"sort"
+
+	"github.com/DanielRivasMD/Babel/internal/bindings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Kind classifies one Change.
+type Kind string
+
+const (
+	Added    Kind = "added"
+	Removed  Kind = "removed"
+	Modified Kind = "modified"
+)
+
+// ActionChange is one ProgramAction that was added, removed, or had its
+// Command changed, matched across revisions by (Program, Action).
+type ActionChange struct {
+	Before *bindings.Action
+	After  *bindings.Action
+}
+
+// Change is one Trigger's difference between two revisions.
+type Change struct {
+	Kind    Kind
+	Trigger bindings.Trigger
+	Before  *bindings.Entry
+	After   *bindings.Entry
+	Actions []ActionChange
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Diff canonicalises old and new by Trigger (bindings.Trigger is already a
+// plain comparable struct of Mode/Modifier/Key, so it doubles as its own
+// map key) and reports every Trigger that was added, removed, or whose
+// Binding, Sequence, or Actions changed. Output is sorted by Trigger for a
+// deterministic, reviewable diff.
+func Diff(old, new []bindings.Entry) []Change {
+	oldByTrigger := indexByTrigger(old)
+	newByTrigger := indexByTrigger(new)
+
+	var changes []Change
+	for _, trig := range sortedTriggers(oldByTrigger) {
+		o := oldByTrigger[trig]
+		n, ok := newByTrigger[trig]
+		if !ok {
+			oCopy := o
+			changes = append(changes, Change{Kind: Removed, Trigger: trig, Before: &oCopy})
+			continue
+		}
+
+		actionChanges := diffActions(o.Actions, n.Actions)
+		if len(actionChanges) > 0 || o.Binding != n.Binding || o.Sequence != n.Sequence {
+			oCopy, nCopy := o, n
+			changes = append(changes, Change{Kind: Modified, Trigger: trig, Before: &oCopy, After: &nCopy, Actions: actionChanges})
+		}
+	}
+	for _, trig := range sortedTriggers(newByTrigger) {
+		if _, ok := oldByTrigger[trig]; ok {
+			continue
+		}
+		nCopy := newByTrigger[trig]
+		changes = append(changes, Change{Kind: Added, Trigger: trig, After: &nCopy})
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return triggerLess(changes[i].Trigger, changes[j].Trigger) })
+	return changes
+}
+
+func indexByTrigger(entries []bindings.Entry) map[bindings.Trigger]bindings.Entry {
+	out := make(map[bindings.Trigger]bindings.Entry, len(entries))
+	for _, e := range entries {
+		out[e.Trigger] = e
+	}
+	return out
+}
+
+func sortedTriggers(byTrigger map[bindings.Trigger]bindings.Entry) []bindings.Trigger {
+	out := make([]bindings.Trigger, 0, len(byTrigger))
+	for trig := range byTrigger {
+		out = append(out, trig)
+	}
+	sort.Slice(out, func(i, j int) bool { return triggerLess(out[i], out[j]) })
+	return out
+}
+
+func triggerLess(a, b bindings.Trigger) bool {
+	if a.Mode != b.Mode {
+		return a.Mode < b.Mode
+	}
+	if a.Modifier != b.Modifier {
+		return a.Modifier < b.Modifier
+	}
+	return a.Key < b.Key
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// diffActions matches actions across revisions by (Program, Action),
+// reporting one ActionChange per action that was added, removed, or whose
+// Command changed.
+func diffActions(old, new []bindings.Action) []ActionChange {
+	oldByKey := indexActions(old)
+	newByKey := indexActions(new)
+
+	var changes []ActionChange
+	for _, key := range sortedActionKeys(oldByKey) {
+		o := oldByKey[key]
+		n, ok := newByKey[key]
+		if !ok {
+			oCopy := o
+			changes = append(changes, ActionChange{Before: &oCopy})
+			continue
+		}
+		if n.Command != o.Command {
+			oCopy, nCopy := o, n
+			changes = append(changes, ActionChange{Before: &oCopy, After: &nCopy})
+		}
+	}
+	for _, key := range sortedActionKeys(newByKey) {
+		if _, ok := oldByKey[key]; ok {
+			continue
+		}
+		nCopy := newByKey[key]
+		changes = append(changes, ActionChange{After: &nCopy})
+	}
+	return changes
+}
+
+func indexActions(actions []bindings.Action) map[string]bindings.Action {
+	out := make(map[string]bindings.Action, len(actions))
+	for _, a := range actions {
+		out[a.Program+"\x00"+a.Action] = a
+	}
+	return out
+}
+
+func sortedActionKeys(byKey map[string]bindings.Action) []string {
+	out := make([]string, 0, len(byKey))
+	for key := range byKey {
+		out = append(out, key)
+	}
+	sort.Strings(out)
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,235 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package diffing renders a unified text diff between two whole-file
+// strings — the `embed --dry-run`/`--diff` preview's line-level complement
+// to internal/diff's trigger-keyed binding diff.
+package diffing
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Unified renders a unified diff (git/diff -u style) between before and
+// after, headed by oldLabel/newLabel as the `--- `/`+++ ` lines, grouping
+// changes into hunks with up to context lines of unchanged context on
+// each side. Returns "" if before == after. context <= 0 is treated as 3.
+func Unified(before, after, oldLabel, newLabel string, context int) string {
+	if before == after {
+		return ""
+	}
+	if context <= 0 {
+		context = 3
+	}
+
+	ops := lineDiff(splitLines(before), splitLines(after))
+	hunks := buildHunks(ops, context)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n", oldLabel)
+	fmt.Fprintf(&out, "+++ %s\n", newLabel)
+	for _, h := range hunks {
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", h.oldStart, h.oldCount, h.newStart, h.newCount)
+		for _, o := range h.ops {
+			switch o.kind {
+			case opEqual:
+				fmt.Fprintf(&out, " %s\n", o.line)
+			case opDelete:
+				fmt.Fprintf(&out, "-%s\n", o.line)
+			case opInsert:
+				fmt.Fprintf(&out, "+%s\n", o.line)
+			}
+		}
+	}
+	return out.String()
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lineDiff returns the edit script turning a into b via a classic
+// longest-common-subsequence alignment. O(len(a)*len(b)) time and space —
+// fine for the config-sized files embed diffs, not meant for huge inputs.
+func lineDiff(a, b []string) []op {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []op
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, op{opEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, op{opDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, op{opInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, op{opDelete, a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, op{opInsert, b[j]})
+	}
+	return ops
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// hunk is one contiguous block of ops plus its 1-indexed line ranges in a
+// and b, in diff -u's native `start,count` numbering.
+type hunk struct {
+	oldStart, oldCount int
+	newStart, newCount int
+	ops                []op
+}
+
+// buildHunks groups ops into unified-diff hunks: each run of non-equal ops
+// plus up to context equal lines of context on either side, merging
+// hunks whose context windows overlap.
+func buildHunks(ops []op, context int) []hunk {
+	var ranges [][2]int
+	changeStart := -1
+	for i, o := range ops {
+		if o.kind != opEqual {
+			if changeStart == -1 {
+				changeStart = i
+			}
+			continue
+		}
+		if changeStart != -1 {
+			ranges = append(ranges, [2]int{changeStart, i})
+			changeStart = -1
+		}
+	}
+	if changeStart != -1 {
+		ranges = append(ranges, [2]int{changeStart, len(ops)})
+	}
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	var expanded [][2]int
+	for _, r := range ranges {
+		start, end := r[0], r[1]
+		for k := 0; k < context && start > 0 && ops[start-1].kind == opEqual; k++ {
+			start--
+		}
+		for k := 0; k < context && end < len(ops) && ops[end].kind == opEqual; k++ {
+			end++
+		}
+		if len(expanded) > 0 && start <= expanded[len(expanded)-1][1] {
+			expanded[len(expanded)-1][1] = end
+		} else {
+			expanded = append(expanded, [2]int{start, end})
+		}
+	}
+
+	var hunks []hunk
+	oldLine, newLine := 1, 1
+	idx := 0
+	for _, r := range expanded {
+		start, end := r[0], r[1]
+		for ; idx < start; idx++ {
+			switch ops[idx].kind {
+			case opEqual:
+				oldLine++
+				newLine++
+			case opDelete:
+				oldLine++
+			case opInsert:
+				newLine++
+			}
+		}
+
+		h := hunk{oldStart: oldLine, newStart: newLine}
+		for ; idx < end; idx++ {
+			o := ops[idx]
+			h.ops = append(h.ops, o)
+			switch o.kind {
+			case opEqual:
+				oldLine++
+				newLine++
+				h.oldCount++
+				h.newCount++
+			case opDelete:
+				oldLine++
+				h.oldCount++
+			case opInsert:
+				newLine++
+				h.newCount++
+			}
+		}
+		hunks = append(hunks, h)
+	}
+	return hunks
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,169 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package forge performs the same templated-substitution-and-concatenate
+// job the external `mbombo forge` binary does, in-process: read inFiles in
+// order, apply each Replace to the concatenated text, then write the
+// result to outFile atomically. It has no knowledge of cobra, flags, or
+// Babel's config dirs — callers in cmd/ own that and report failures
+// however their layer already does.
+package forge
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Replace is one old→new substitution. Regex interprets Old as a regular
+// expression (New may then use Go regexp replacement syntax, e.g. "$1");
+// WholeWord wraps a literal Old in \b...\b boundaries so it only matches
+// whole words. The two are independent: WholeWord on a Regex Old wraps the
+// pattern itself in boundaries too. WholeLine replaces the entire line a
+// match of Old is found on with New, verbatim (New is never interpreted as
+// a regexp replacement template, even when Regex is set) — the old mbombo
+// binary's ":line"-suffix convention, reimplemented natively here.
+type Replace struct {
+	Old       string
+	New       string
+	Regex     bool
+	WholeWord bool
+	WholeLine bool
+}
+
+// Error reports a failed forge step: which file was being read or written
+// when Err occurred.
+type Error struct {
+	Op   string
+	Path string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("forge: %s %s: %v", e.Op, e.Path, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Render concatenates inFiles in order and applies replaces to the
+// result, returning the text before and after — the same read+apply work
+// Forge does, minus the atomic write, so a caller that only wants to
+// preview a forge (e.g. a --dry-run/--diff mode) doesn't duplicate it.
+func Render(inFiles []string, replaces []Replace) (before, after string, err error) {
+	var buf strings.Builder
+	for _, path := range inFiles {
+		content, rerr := os.ReadFile(path)
+		if rerr != nil {
+			return "", "", &Error{Op: "read", Path: path, Err: rerr}
+		}
+		buf.Write(content)
+	}
+	before = buf.String()
+
+	after = before
+	for _, r := range replaces {
+		applied, aerr := r.apply(after)
+		if aerr != nil {
+			return "", "", aerr
+		}
+		after = applied
+	}
+	return before, after, nil
+}
+
+// Forge concatenates inFiles in order, applies replaces to the result, and
+// writes it to outFile. The write is atomic: the result lands at
+// outFile+".tmp" first, then is renamed over outFile, so a crash or a
+// failing replacement never leaves outFile half-written.
+func Forge(outFile string, inFiles []string, replaces []Replace) error {
+	_, text, err := Render(inFiles, replaces)
+	if err != nil {
+		if _, ok := err.(*Error); ok {
+			return err
+		}
+		return &Error{Op: "replace", Path: outFile, Err: err}
+	}
+
+	tmp := outFile + ".tmp"
+	if err := os.WriteFile(tmp, []byte(text), 0o644); err != nil {
+		return &Error{Op: "write", Path: tmp, Err: err}
+	}
+	if err := os.Rename(tmp, outFile); err != nil {
+		return &Error{Op: "rename", Path: outFile, Err: err}
+	}
+	return nil
+}
+
+// apply runs one Replace against text.
+func (r Replace) apply(text string) (string, error) {
+	if r.WholeLine {
+		return r.applyWholeLine(text)
+	}
+
+	if !r.Regex {
+		old := r.Old
+		if !r.WholeWord {
+			return strings.ReplaceAll(text, old, r.New), nil
+		}
+		old = regexp.QuoteMeta(old)
+		re, err := regexp.Compile(`\b` + old + `\b`)
+		if err != nil {
+			return "", err
+		}
+		return re.ReplaceAllString(text, r.New), nil
+	}
+
+	pattern := r.Old
+	if r.WholeWord {
+		pattern = `\b(?:` + pattern + `)\b`
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", err
+	}
+	return re.ReplaceAllString(text, r.New), nil
+}
+
+// applyWholeLine replaces every line containing a match of Old with New.
+// New is substituted literally (via ReplaceAllLiteralString) rather than as
+// a regexp template, since WholeLine callers hand it pre-rendered config
+// text that may itself contain "$"-like runes.
+func (r Replace) applyWholeLine(text string) (string, error) {
+	pattern := r.Old
+	if !r.Regex {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if r.WholeWord {
+		pattern = `\b(?:` + pattern + `)\b`
+	}
+	re, err := regexp.Compile(`(?m)^.*(?:` + pattern + `).*$`)
+	if err != nil {
+		return "", err
+	}
+	return re.ReplaceAllLiteralString(text, r.New), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
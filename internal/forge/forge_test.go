@@ -0,0 +1,195 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package forge
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestReplaceApply(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Replace
+		in   string
+		want string
+	}{
+		{
+			name: "literal",
+			r:    Replace{Old: "foo", New: "bar"},
+			in:   "foo foobar foo",
+			want: "bar barbar bar",
+		},
+		{
+			name: "whole word",
+			r:    Replace{Old: "foo", New: "bar", WholeWord: true},
+			in:   "foo foobar foo",
+			want: "bar foobar bar",
+		},
+		{
+			name: "regex",
+			r:    Replace{Old: `f(\w+)`, New: "$1", Regex: true},
+			in:   "foo fizz",
+			want: "oo izz",
+		},
+		{
+			name: "regex whole word",
+			r:    Replace{Old: `f\w+`, New: "X", Regex: true, WholeWord: true},
+			in:   "foo barfoo",
+			want: "X barfoo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.r.apply(tt.in)
+			if err != nil {
+				t.Fatalf("apply(%q) returned error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("apply(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWholeLineReplace mirrors the embedConfigRaw lazygit/zellij/broot
+// call shape: Old anchors a quoted placeholder embedded in a larger line,
+// and New is a freshly rendered whole line standing in for it. Before
+// WholeLine existed, callers suffixed New with a literal ":line" marker
+// that the external mbombo binary interpreted specially but forge.Replace
+// spliced in verbatim, corrupting the line.
+func TestWholeLineReplace(t *testing.T) {
+	in := "  keys:\n    key1: '<old-cmd>'\n    key2: '<other>'\n"
+	r := Replace{Old: "<old-cmd>", New: "    key1: '<new-cmd>'", WholeLine: true}
+
+	got, err := r.apply(in)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	want := "  keys:\n    key1: '<new-cmd>'\n    key2: '<other>'\n"
+	if got != want {
+		t.Errorf("apply() = %q, want %q", got, want)
+	}
+	if strings.Contains(got, ":line") {
+		t.Errorf("apply() left ':line' garbage in output: %q", got)
+	}
+}
+
+func TestRender(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.txt")
+	b := filepath.Join(dir, "b.txt")
+	if err := os.WriteFile(a, []byte("hello "), 0o644); err != nil {
+		t.Fatalf("WriteFile(a): %v", err)
+	}
+	if err := os.WriteFile(b, []byte("world"), 0o644); err != nil {
+		t.Fatalf("WriteFile(b): %v", err)
+	}
+
+	before, after, err := Render([]string{a, b}, []Replace{{Old: "world", New: "there"}})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if before != "hello world" {
+		t.Errorf("before = %q, want %q", before, "hello world")
+	}
+	if after != "hello there" {
+		t.Errorf("after = %q, want %q", after, "hello there")
+	}
+}
+
+// TestRenderWholeLineNoLineGarbage runs a WholeLine Replace through Render
+// end to end (file read through concatenation, the same path
+// embedConfigRaw drives via mbomboForging) and confirms the rendered
+// output never contains the dead ":line" marker.
+func TestRenderWholeLineNoLineGarbage(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "config.yml")
+	if err := os.WriteFile(cfg, []byte("keybindings:\n  commit: '<exec-placeholder>'\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	r := Replace{
+		Old:       "exec-placeholder",
+		New:       "  commit: '<git commit>'",
+		WholeLine: true,
+	}
+
+	_, after, err := Render([]string{cfg}, []Replace{r})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	if strings.Contains(after, ":line") {
+		t.Errorf("Render() left ':line' garbage in output: %q", after)
+	}
+	want := "keybindings:\n  commit: '<git commit>'\n"
+	if after != want {
+		t.Errorf("Render() = %q, want %q", after, want)
+	}
+}
+
+func TestRenderMissingFile(t *testing.T) {
+	_, _, err := Render([]string{filepath.Join(t.TempDir(), "missing.txt")}, nil)
+	if err == nil {
+		t.Fatal("Render with a missing input file: want error, got nil")
+	}
+	var ferr *Error
+	if !errors.As(err, &ferr) {
+		t.Fatalf("Render error = %v, want *Error", err)
+	}
+	if ferr.Op != "read" {
+		t.Errorf("Error.Op = %q, want %q", ferr.Op, "read")
+	}
+}
+
+func TestForgeAtomicWrite(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "in.txt")
+	out := filepath.Join(dir, "out.txt")
+	if err := os.WriteFile(in, []byte("foo bar"), 0o644); err != nil {
+		t.Fatalf("WriteFile(in): %v", err)
+	}
+
+	if err := Forge(out, []string{in}, []Replace{{Old: "foo", New: "baz"}}); err != nil {
+		t.Fatalf("Forge: %v", err)
+	}
+
+	got, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("ReadFile(out): %v", err)
+	}
+	if string(got) != "baz bar" {
+		t.Errorf("out content = %q, want %q", got, "baz bar")
+	}
+
+	if _, err := os.Stat(out + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("Forge left a .tmp file behind: %v", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
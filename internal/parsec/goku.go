@@ -0,0 +1,154 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package parsec
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"regexp"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// KeyDescriptor is a decoded Goku key keyword such as ":!TC#Pa" or
+// ":!TC#Popen_bracket": an optional layer/mode Prefix (the "TC" in the
+// examples) and the trailing Key name ("a", "open_bracket").
+type KeyDescriptor struct {
+	Prefix string
+	Key    string
+}
+
+var (
+	reLeader = regexp.MustCompile(`[A-Za-z0-9_]+`)
+	reKey    = regexp.MustCompile(`[A-Za-z0-9_]+`)
+)
+
+// keyDescriptorParser recognises an optional leading ":" and "!", a Prefix
+// run of word characters, the literal "#P" marker, and a trailing Key run.
+// It replaces the fmt.Sprintf(":!%s#P%s", prefix, letter)-per-candidate
+// matching that parseEdnConfig used to do against every possible letter.
+var keyDescriptorParser = Seq2(
+	Seq2(
+		Seq2(Optional(Literal(":")), Optional(Literal("!")), func(Maybe[string], Maybe[string]) struct{} { return struct{}{} }),
+		Regex(reLeader),
+		func(_ struct{}, prefix string) string { return prefix },
+	),
+	Seq2(Literal("#P"), Regex(reKey), func(_ string, key string) string { return key }),
+	func(prefix, key string) KeyDescriptor { return KeyDescriptor{Prefix: prefix, Key: key} },
+)
+
+// ParseKeyDescriptor parses a Goku key keyword (with or without the
+// leading ":!") into its Prefix/Key parts, plus the Position the Key name
+// starts at for --debug-style error reporting.
+func ParseKeyDescriptor(s string) (KeyDescriptor, Position, error) {
+	rest, kd, err := keyDescriptorParser(NewInput(s))
+	if err != nil {
+		return KeyDescriptor{}, Position{}, err
+	}
+	// Position of the Key itself: back up by len(kd.Key) from where we stopped.
+	keyStart := rest
+	keyStart.Offset -= len(kd.Key)
+	keyStart.Col -= len(kd.Key)
+	return kd, keyStart.Pos(), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ChordTrigger is a chorded sequence of KeyDescriptors, one per token of a
+// space-separated Goku trigger like "!TC#Pa !TC#Pb".
+type ChordTrigger []KeyDescriptor
+
+// ParseChordTrigger splits s on whitespace and parses every token as a
+// KeyDescriptor, failing on the first token that doesn't decode.
+func ParseChordTrigger(s string) (ChordTrigger, error) {
+	var chord ChordTrigger
+	for _, tok := range strings.Fields(s) {
+		kd, _, err := ParseKeyDescriptor(tok)
+		if err != nil {
+			return nil, err
+		}
+		chord = append(chord, kd)
+	}
+	return chord, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// StreamRules walks the top-level forms of a Goku ":rules [...]" vector one
+// at a time, calling yield with each raw rule form's source text and the
+// Position it starts at. It never builds a slice of every rule in memory:
+// each form is located by bracket-depth scanning and handed to yield before
+// the next one is found. yield returning false stops the walk early.
+func StreamRules(text string, yield func(rule string, pos Position) bool) {
+	idx := strings.Index(text, ":rules")
+	if idx < 0 {
+		return
+	}
+	rest := text[idx:]
+	open := strings.IndexRune(rest, '[')
+	if open < 0 {
+		return
+	}
+
+	in := NewInput(text)
+	in = in.Advance(idx + open + 1) // past ":rules" and the opening '['
+
+	for {
+		// skip whitespace up to the next form or the closing ']'
+		for !in.Done() {
+			r := in.Rest()[0]
+			if r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ',' {
+				in = in.Advance(1)
+				continue
+			}
+			break
+		}
+		if in.Done() || in.Rest()[0] != '[' {
+			return // closing ']' of :rules, or EOF
+		}
+
+		start := in
+		depth := 0
+	scan:
+		for !in.Done() {
+			switch in.Rest()[0] {
+			case '[':
+				depth++
+				in = in.Advance(1)
+			case ']':
+				depth--
+				in = in.Advance(1)
+				if depth == 0 {
+					break scan
+				}
+			default:
+				in = in.Advance(1)
+			}
+		}
+		if depth != 0 {
+			return // unbalanced brackets: stop rather than misreport a form
+		}
+
+		form := text[start.Offset:in.Offset]
+		if !yield(form, start.Pos()) {
+			return
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
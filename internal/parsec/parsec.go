@@ -0,0 +1,232 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package parsec is a minimal parser-combinator toolkit in the style of
+// helix-parsec: a Parser[T] consumes an Input and either returns the
+// remaining Input plus a value, or an error. Combinators (Map, Seq2, Or,
+// Many, Optional) build bigger parsers out of smaller ones without ever
+// slurping a whole file into an intermediate map[string]interface{}, and
+// every Input carries its byte offset plus line/column so a failed or
+// successful parse can report exactly where it happened.
+package parsec
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Position is a 1-indexed line/column location within a parsed source.
+type Position struct {
+	Line int
+	Col  int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Col)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Input is the cursor a Parser advances over. Text is the full source so
+// Pos-relative slicing never reallocates; Offset is the byte index of the
+// next unconsumed rune.
+type Input struct {
+	Text   string
+	Offset int
+	Line   int
+	Col    int
+}
+
+// NewInput starts a cursor at the beginning of text, line 1 column 1.
+func NewInput(text string) Input {
+	return Input{Text: text, Offset: 0, Line: 1, Col: 1}
+}
+
+// Pos reports the current line/column of the cursor.
+func (in Input) Pos() Position {
+	return Position{Line: in.Line, Col: in.Col}
+}
+
+// Rest returns the unconsumed remainder of Text.
+func (in Input) Rest() string {
+	return in.Text[in.Offset:]
+}
+
+// Done reports whether the cursor has reached the end of Text.
+func (in Input) Done() bool {
+	return in.Offset >= len(in.Text)
+}
+
+// Advance moves the cursor past n bytes of the remainder, updating Line/Col
+// for every newline crossed.
+func (in Input) Advance(n int) Input {
+	consumed := in.Rest()[:n]
+	out := in
+	out.Offset += n
+	for _, r := range consumed {
+		if r == '\n' {
+			out.Line++
+			out.Col = 1
+		} else {
+			out.Col++
+		}
+	}
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ParseError reports a failed parse at a source Position.
+type ParseError struct {
+	Pos     Position
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Message)
+}
+
+func errAt(in Input, format string, args ...any) error {
+	return &ParseError{Pos: in.Pos(), Message: fmt.Sprintf(format, args...)}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Parser consumes a prefix of an Input and produces a T, or fails without
+// consuming anything (so Or can try the next alternative).
+type Parser[T any] func(Input) (Input, T, error)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Literal matches an exact string and returns it verbatim.
+func Literal(s string) Parser[string] {
+	return func(in Input) (Input, string, error) {
+		if !strings.HasPrefix(in.Rest(), s) {
+			return in, "", errAt(in, "expected %q", s)
+		}
+		return in.Advance(len(s)), s, nil
+	}
+}
+
+// Regex matches the leading prefix of the input against re and returns the
+// full match. The pattern is anchored at the start of the remainder.
+func Regex(re *regexp.Regexp) Parser[string] {
+	anchored := re
+	if !strings.HasPrefix(re.String(), "^") {
+		anchored = regexp.MustCompile("^(?:" + re.String() + ")")
+	}
+	return func(in Input) (Input, string, error) {
+		m := anchored.FindString(in.Rest())
+		if m == "" {
+			return in, "", errAt(in, "no match for /%s/", re.String())
+		}
+		return in.Advance(len(m)), m, nil
+	}
+}
+
+// Map transforms the value produced by p, leaving failures untouched.
+func Map[T, U any](p Parser[T], f func(T) U) Parser[U] {
+	return func(in Input) (Input, U, error) {
+		rest, v, err := p(in)
+		if err != nil {
+			var zero U
+			return in, zero, err
+		}
+		return rest, f(v), nil
+	}
+}
+
+// Seq2 runs a then b in sequence, combining their values with f.
+func Seq2[A, B, C any](a Parser[A], b Parser[B], f func(A, B) C) Parser[C] {
+	return func(in Input) (Input, C, error) {
+		var zero C
+		rest, av, err := a(in)
+		if err != nil {
+			return in, zero, err
+		}
+		rest, bv, err := b(rest)
+		if err != nil {
+			return in, zero, err
+		}
+		return rest, f(av, bv), nil
+	}
+}
+
+// Or tries each parser in order, returning the first that succeeds.
+func Or[T any](parsers ...Parser[T]) Parser[T] {
+	return func(in Input) (Input, T, error) {
+		var zero T
+		if len(parsers) == 0 {
+			return in, zero, errAt(in, "no alternatives")
+		}
+		var lastErr error
+		for _, p := range parsers {
+			rest, v, err := p(in)
+			if err == nil {
+				return rest, v, nil
+			}
+			lastErr = err
+		}
+		return in, zero, lastErr
+	}
+}
+
+// Many runs p until it fails, collecting every successful value. A Many
+// parser never itself fails — zero matches yields an empty slice.
+func Many[T any](p Parser[T]) Parser[[]T] {
+	return func(in Input) (Input, []T, error) {
+		var out []T
+		rest := in
+		for {
+			next, v, err := p(rest)
+			if err != nil {
+				break
+			}
+			if next.Offset == rest.Offset {
+				break // guard against zero-width parsers looping forever
+			}
+			out = append(out, v)
+			rest = next
+		}
+		return rest, out, nil
+	}
+}
+
+// Optional runs p; on failure it returns the zero value without consuming
+// input or propagating the error.
+func Optional[T any](p Parser[T]) Parser[Maybe[T]] {
+	return func(in Input) (Input, Maybe[T], error) {
+		rest, v, err := p(in)
+		if err != nil {
+			return in, Maybe[T]{}, nil
+		}
+		return rest, Maybe[T]{Value: v, Present: true}, nil
+	}
+}
+
+// Maybe holds the outcome of an Optional parse.
+type Maybe[T any] struct {
+	Value   T
+	Present bool
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,138 @@
+/*
+Copyright © 2025 Daniel Rivas <danielrivasmd@gmail.com>
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package layouts holds pure row/column geometry for the keyboard shapes
+// the heatmap renderer can draw — no parsing, no rendering, just the
+// (column, row, width, height) of every cell a KeyboardConfig label maps
+// onto. Keeping this data free of cmd lets new shapes be added without
+// touching the SVG/PNG renderer at all.
+package layouts
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Cell places one labeled key on the grid, in key-unit columns/rows (1.0 =
+// one standard keycap). Label matches a KeyboardConfig.Letters or
+// .SpecialKeys key, e.g. "a" or "delete_or_backspace".
+type Cell struct {
+	Label  string
+	Col    float64
+	Row    float64
+	Width  float64
+	Height float64
+}
+
+// Layout is a named arrangement of Cells.
+type Layout struct {
+	Name  string
+	Cells []Cell
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// row builds one row of unit-width Cells starting at (startCol, row), in
+// label order, with an optional trailing Cell of different width (e.g. the
+// row's wide terminator key).
+func row(row float64, startCol float64, labels []string, trailing ...Cell) []Cell {
+	cells := make([]Cell, 0, len(labels)+len(trailing))
+	col := startCol
+	for _, label := range labels {
+		cells = append(cells, Cell{Label: label, Col: col, Row: row, Width: 1, Height: 1})
+		col++
+	}
+	for _, t := range trailing {
+		t.Col = col
+		t.Row = row
+		cells = append(cells, t)
+		col += t.Width
+	}
+	return cells
+}
+
+var qwertyRow = []string{"q", "w", "e", "r", "t", "y", "u", "i", "o", "p"}
+var homeRow = []string{"a", "s", "d", "f", "g", "h", "j", "k", "l"}
+var bottomRow = []string{"z", "x", "c", "v", "b", "n", "m"}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ANSI is the standard staggered ANSI 104-key row layout, restricted to the
+// QWERTY block plus the punctuation/modifier keys KeyboardConfig tracks.
+var ANSI = Layout{
+	Name: "ansi",
+	Cells: concat(
+		row(0, 0, qwertyRow, Cell{Label: "open_bracket", Width: 1}, Cell{Label: "close_bracket", Width: 1}, Cell{Label: "backslash", Width: 1.5}),
+		row(1, 0.25, homeRow, Cell{Label: "semicolon", Width: 1}, Cell{Label: "quote", Width: 1}, Cell{Label: "return_or_enter", Width: 2.25}),
+		row(2, 0.75, bottomRow, Cell{Label: "comma", Width: 1}, Cell{Label: "period", Width: 1}, Cell{Label: "slash", Width: 1}, Cell{Label: "right_shift", Width: 2.25}),
+		row(3, 2.25, nil, Cell{Label: "spacebar", Width: 6.25}, Cell{Label: "right_command", Width: 1.25}, Cell{Label: "right_option", Width: 1.25}),
+		row(0, 13, nil, Cell{Label: "delete_or_backspace", Width: 2}),
+	),
+}
+
+// ISO is the ANSI layout with the wider, two-row ISO enter key and a
+// shorter left shift-side gap (the extra ISO key itself isn't in
+// KeyboardConfig, so only the affected neighbours shift).
+var ISO = Layout{
+	Name: "iso",
+	Cells: concat(
+		row(0, 0, qwertyRow, Cell{Label: "open_bracket", Width: 1}, Cell{Label: "close_bracket", Width: 1}),
+		row(1, 0.25, homeRow, Cell{Label: "semicolon", Width: 1}, Cell{Label: "quote", Width: 1}, Cell{Label: "return_or_enter", Width: 1.25, Height: 2}),
+		row(2, 0, append([]string{"backslash"}, bottomRow...), Cell{Label: "comma", Width: 1}, Cell{Label: "period", Width: 1}, Cell{Label: "slash", Width: 1}, Cell{Label: "right_shift", Width: 2.25}),
+		row(3, 2.25, nil, Cell{Label: "spacebar", Width: 6.25}, Cell{Label: "right_command", Width: 1.25}, Cell{Label: "right_option", Width: 1.25}),
+		row(0, 13, nil, Cell{Label: "delete_or_backspace", Width: 2}),
+	),
+}
+
+// Ortholinear lays every key out on an unstaggered unit grid, the shape
+// split ergo boards (e.g. ortho/columnar thumb-cluster keyboards) use.
+var Ortholinear = Layout{
+	Name: "ortholinear",
+	Cells: concat(
+		row(0, 0, qwertyRow, Cell{Label: "open_bracket", Width: 1}, Cell{Label: "close_bracket", Width: 1}, Cell{Label: "backslash", Width: 1}),
+		row(1, 0, homeRow, Cell{Label: "semicolon", Width: 1}, Cell{Label: "quote", Width: 1}, Cell{Label: "return_or_enter", Width: 1}),
+		row(2, 0, bottomRow, Cell{Label: "comma", Width: 1}, Cell{Label: "period", Width: 1}, Cell{Label: "slash", Width: 1}, Cell{Label: "right_shift", Width: 1}),
+		row(3, 3, nil, Cell{Label: "spacebar", Width: 2}, Cell{Label: "right_command", Width: 1}, Cell{Label: "right_option", Width: 1}),
+		row(0, 12, nil, Cell{Label: "delete_or_backspace", Width: 1}),
+	),
+}
+
+func concat(groups ...[]Cell) []Cell {
+	var out []Cell
+	for _, g := range groups {
+		out = append(out, g...)
+	}
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var byName = map[string]Layout{
+	ANSI.Name:        ANSI,
+	ISO.Name:         ISO,
+	Ortholinear.Name: Ortholinear,
+}
+
+// Get looks up a Layout by name (ansi, iso, ortholinear).
+func Get(name string) (Layout, bool) {
+	l, ok := byName[name]
+	return l, ok
+}
+
+// Names lists every registered Layout name, for flag completion.
+func Names() []string {
+	return []string{ANSI.Name, ISO.Name, Ortholinear.Name}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////